@@ -0,0 +1,7 @@
+data "slack_admin_app_requests" "pending" {
+  team_id = "T0123456789"
+}
+
+output "requested_apps" {
+  value = [for request in data.slack_admin_app_requests.pending.requests : "${request.app_name} (${request.user_email})"]
+}
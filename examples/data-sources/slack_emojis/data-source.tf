@@ -0,0 +1,14 @@
+data "slack_emojis" "this" {}
+
+resource "slack_user_status" "example" {
+  id           = "U0123456789"
+  status_text  = "Shipping"
+  status_emoji = ":shipit:"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.slack_emojis.this.names, "shipit")
+      error_message = "The :shipit: emoji does not exist in the workspace."
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "slack_user_presence" "example" {
+  id = "U0123456789"
+}
+
+output "example" {
+  value = data.slack_user_presence.example
+}
@@ -0,0 +1,20 @@
+variable "slack_client_secret" {
+  type      = string
+  ephemeral = true
+}
+
+variable "slack_oauth_code" {
+  type      = string
+  ephemeral = true
+}
+
+ephemeral "slack_oauth_v2_access" "install" {
+  client_id     = "1234567890.1234567890"
+  client_secret = var.slack_client_secret
+  code          = var.slack_oauth_code
+}
+
+provider "slack" {
+  alias     = "installed"
+  api_token = ephemeral.slack_oauth_v2_access.install.access_token
+}
@@ -0,0 +1,13 @@
+variable "slack_refresh_token" {
+  type      = string
+  ephemeral = true
+}
+
+ephemeral "slack_token_rotation" "config" {
+  refresh_token = var.slack_refresh_token
+}
+
+provider "slack" {
+  alias     = "rotated"
+  api_token = ephemeral.slack_token_rotation.config.token
+}
@@ -0,0 +1 @@
+terraform import slack_admin_app_policy.poll A0123456789/T0123456789
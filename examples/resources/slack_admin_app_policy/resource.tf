@@ -0,0 +1,5 @@
+resource "slack_admin_app_policy" "poll" {
+  app_id     = "A0123456789"
+  team_id    = "T0123456789"
+  resolution = "approved"
+}
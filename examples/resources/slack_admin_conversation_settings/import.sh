@@ -0,0 +1 @@
+terraform import slack_admin_conversation_settings.announcements C0123456789
@@ -0,0 +1,7 @@
+resource "slack_admin_conversation_settings" "announcements" {
+  channel      = "#announcements"
+  who_can_post = ["type:admin", "subteam:S0123456789"]
+  can_thread   = ["type:admin"]
+  is_private   = true
+  team_ids     = ["T0123456789", "T9876543210"]
+}
@@ -0,0 +1 @@
+terraform import slack_admin_role_assignment.channel_managers Rl0A/C0123456789
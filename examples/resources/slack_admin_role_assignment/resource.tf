@@ -0,0 +1,8 @@
+resource "slack_admin_role_assignment" "channel_managers" {
+  role_id    = "Rl0A"
+  entity_ids = ["C0123456789"]
+  users = [
+    "alice@example.com",
+    "U0123456789",
+  ]
+}
@@ -0,0 +1 @@
+terraform import slack_admin_user_group_link.engineering S0123456789
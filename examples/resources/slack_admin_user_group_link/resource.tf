@@ -0,0 +1,9 @@
+resource "slack_admin_user_group_link" "engineering" {
+  usergroup      = "S0123456789"
+  team_ids       = ["T0123456789", "T9876543210"]
+  auto_provision = true
+  channel_ids = [
+    "C0123456789",
+    "C9876543210",
+  ]
+}
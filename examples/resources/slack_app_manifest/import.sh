@@ -0,0 +1 @@
+terraform import slack_app_manifest.poll A0123456789
@@ -0,0 +1,20 @@
+resource "slack_app_manifest" "poll" {
+  manifest = <<-EOT
+    display_information:
+      name: Poll
+      description: Runs quick polls in channels
+    features:
+      bot_user:
+        display_name: Poll
+        always_online: false
+    oauth_config:
+      scopes:
+        bot:
+          - chat:write
+          - commands
+  EOT
+}
+
+output "poll_install_url" {
+  value = slack_app_manifest.poll.oauth_authorize_url
+}
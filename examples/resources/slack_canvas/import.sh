@@ -0,0 +1 @@
+terraform import slack_canvas.incident_process F0123456789
@@ -0,0 +1,24 @@
+resource "slack_canvas" "platform_wiki" {
+  channel = "platform"
+  title   = "Platform wiki"
+  content = file("${path.module}/platform-wiki.md")
+}
+
+resource "slack_canvas" "incident_process" {
+  title   = "Incident process"
+  content = <<-EOT
+    # Incident process
+
+    1. Declare the incident in #incidents
+    2. Page the on-call engineer
+    3. Write the postmortem within five days
+  EOT
+
+  channel_access = {
+    C0123456789 = "read"
+  }
+
+  user_access = {
+    U0123456789 = "write"
+  }
+}
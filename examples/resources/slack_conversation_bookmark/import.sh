@@ -0,0 +1 @@
+terraform import slack_conversation_bookmark.runbook C0123456789/Bk0123456789
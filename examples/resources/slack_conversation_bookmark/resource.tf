@@ -0,0 +1,21 @@
+locals {
+  team_channels = ["platform", "payments", "search"]
+}
+
+resource "slack_conversation_bookmark" "runbook" {
+  for_each = toset(local.team_channels)
+
+  channel = each.value
+  title   = "Runbook"
+  link    = "https://wiki.example.com/runbooks/${each.value}"
+  emoji   = ":books:"
+}
+
+resource "slack_conversation_bookmark" "on_call" {
+  for_each = toset(local.team_channels)
+
+  channel = each.value
+  title   = "On-call schedule"
+  link    = "https://oncall.example.com/schedules/${each.value}"
+  emoji   = ":pager:"
+}
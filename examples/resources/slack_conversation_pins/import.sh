@@ -0,0 +1 @@
+terraform import slack_conversation_pins.general C0123456789
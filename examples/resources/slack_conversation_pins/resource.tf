@@ -0,0 +1,25 @@
+resource "slack_message" "help" {
+  channel = "general"
+  text    = "How to get help: ask in #help or page the on-call engineer."
+}
+
+resource "slack_message" "code_of_conduct" {
+  channel = "general"
+  text    = "Please read our code of conduct: https://example.com/conduct"
+}
+
+# Manages every pin in the channel
+resource "slack_conversation_pins" "general" {
+  channel = slack_message.help.channel_id
+  message_timestamps = [
+    slack_message.help.ts,
+    slack_message.code_of_conduct.ts,
+  ]
+}
+
+# Pins a single message and leaves other pins alone
+resource "slack_conversation_pins" "announcement" {
+  channel            = "announcements"
+  authoritative      = false
+  message_timestamps = ["1700000000.000100"]
+}
@@ -0,0 +1 @@
+terraform import slack_conversation_retention.legal C0123456789
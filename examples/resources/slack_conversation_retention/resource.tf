@@ -0,0 +1,4 @@
+resource "slack_conversation_retention" "legal" {
+  channel       = "#legal"
+  duration_days = 2555
+}
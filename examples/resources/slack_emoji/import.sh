@@ -0,0 +1 @@
+terraform import slack_emoji.shipit shipit
@@ -0,0 +1,14 @@
+resource "slack_emoji" "shipit" {
+  name = "shipit"
+  file = "${path.module}/emoji/shipit.png"
+}
+
+resource "slack_emoji" "partyparrot" {
+  name = "partyparrot"
+  url  = "https://example.com/emoji/partyparrot.gif"
+}
+
+resource "slack_emoji" "squirrel" {
+  name      = "squirrel"
+  alias_for = slack_emoji.shipit.name
+}
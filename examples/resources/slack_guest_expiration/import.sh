@@ -0,0 +1 @@
+terraform import slack_guest_expiration.contractor U0123456789/T0123456789
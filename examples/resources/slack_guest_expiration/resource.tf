@@ -0,0 +1,6 @@
+resource "slack_guest_expiration" "contractor" {
+  user        = "contractor@example.com"
+  expiration  = "2030-01-31T17:00:00Z"
+  guest_type  = "single_channel"
+  channel_ids = ["C0123456789"]
+}
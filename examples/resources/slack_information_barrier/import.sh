@@ -0,0 +1 @@
+terraform import slack_information_barrier.legal B0123456789
@@ -0,0 +1,5 @@
+resource "slack_information_barrier" "legal" {
+  primary_usergroup         = "legal"
+  barriered_from_usergroups = ["sales", "S0123456789"]
+  restricted_subjects       = ["im", "mpim", "call"]
+}
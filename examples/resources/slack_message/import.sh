@@ -0,0 +1 @@
+terraform import slack_message.help C0123456789/1700000000.000100
@@ -0,0 +1,26 @@
+resource "slack_message" "help" {
+  channel = "general"
+  text    = "How to get help: ask in #help or page the on-call engineer."
+  pin     = true
+}
+
+resource "slack_message" "runbooks" {
+  channel      = "C0123456789"
+  text         = "Runbook index"
+  unfurl_links = false
+  blocks = jsonencode([
+    {
+      type = "section"
+      text = {
+        type = "mrkdwn"
+        text = "*Runbook index*\n<https://example.com/runbooks|All runbooks>"
+      }
+    }
+  ])
+}
+
+resource "slack_message" "reply" {
+  channel   = slack_message.runbooks.channel_id
+  thread_ts = slack_message.runbooks.ts
+  text      = "Ask questions about the runbooks in this thread."
+}
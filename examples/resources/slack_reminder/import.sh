@@ -0,0 +1 @@
+terraform import slack_reminder.timesheets Rm0123456789
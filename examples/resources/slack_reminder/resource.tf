@@ -0,0 +1,12 @@
+resource "slack_reminder" "timesheets" {
+  text                = "Submit your timesheets"
+  time                = "2030-01-04T16:00:00Z"
+  recurrence          = "weekly"
+  recurrence_weekdays = ["friday"]
+  user                = "U0123456789"
+}
+
+resource "slack_reminder" "standup" {
+  text = "Post your standup notes"
+  time = "every weekday at 9am"
+}
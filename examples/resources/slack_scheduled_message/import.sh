@@ -0,0 +1 @@
+terraform import slack_scheduled_message.maintenance C0123456789/Q1298393284
@@ -0,0 +1,20 @@
+resource "slack_scheduled_message" "maintenance" {
+  channel = "general"
+  post_at = "2025-01-31T21:00:00+10:00"
+  text    = "Maintenance starts in one hour. Expect the VPN to be unavailable until midnight."
+}
+
+resource "slack_scheduled_message" "maintenance_done" {
+  channel = "C0123456789"
+  post_at = "2025-02-01T00:00:00+10:00"
+  text    = "Maintenance is complete"
+  blocks = jsonencode([
+    {
+      type = "section"
+      text = {
+        type = "mrkdwn"
+        text = ":white_check_mark: *Maintenance is complete*"
+      }
+    }
+  ])
+}
@@ -0,0 +1 @@
+terraform import slack_scim_group.engineering S0123456789
@@ -0,0 +1,7 @@
+resource "slack_scim_group" "engineering" {
+  display_name = "engineering"
+  members = [
+    slack_scim_user.alice.id,
+    "W0123456789",
+  ]
+}
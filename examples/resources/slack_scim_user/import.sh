@@ -0,0 +1 @@
+terraform import slack_scim_user.alice W0123456789
@@ -0,0 +1,7 @@
+resource "slack_scim_user" "alice" {
+  user_name   = "alice"
+  emails      = ["alice@example.com"]
+  given_name  = "Alice"
+  family_name = "Example"
+  title       = "Engineer"
+}
@@ -0,0 +1 @@
+terraform import slack_team_settings.engineering T0123456789
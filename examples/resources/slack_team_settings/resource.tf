@@ -0,0 +1,7 @@
+resource "slack_team_settings" "engineering" {
+  team_id          = "T0123456789"
+  name             = "Acme Engineering"
+  description      = "Where Acme builds things"
+  discoverability  = "invite_only"
+  default_channels = ["#general", "#engineering-announcements"]
+}
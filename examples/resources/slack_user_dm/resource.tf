@@ -0,0 +1,12 @@
+resource "slack_user_dm" "on_call" {
+  users = ["oncall@example.com"]
+}
+
+resource "slack_user_dm" "leads" {
+  users = ["alice@example.com", "bob@example.com", "U0123456789"]
+}
+
+resource "slack_message" "handover" {
+  channel = slack_user_dm.on_call.channel_id
+  text    = "You are on call this week."
+}
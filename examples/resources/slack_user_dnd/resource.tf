@@ -0,0 +1,3 @@
+resource "slack_user_dnd" "on_call" {
+  snooze_minutes = 60
+}
@@ -0,0 +1,6 @@
+resource "slack_user_photo" "deploy_bot" {
+  file   = "${path.module}/avatars/deploy-bot.png"
+  crop_x = 0
+  crop_y = 0
+  crop_w = 512
+}
@@ -0,0 +1,3 @@
+resource "slack_user_presence" "on_call" {
+  presence = "away"
+}
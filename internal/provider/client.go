@@ -1,31 +1,295 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-slack/internal/slackutil"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/slack-go/slack"
 )
 
 // ConfiguredClient holds the configuration for the provider, including the Slack API client.
 type slackClient struct {
 	*slack.Client
-	RawResponse string //extended attribute
+	APIURL      string       // base URL of the Slack Web API
+	HTTPClient  *http.Client // HTTP client shared by the Slack API client
+	ReadOnly    bool         // reject every call that could mutate Slack
+	TeamID      string       // default workspace for Enterprise Grid, empty for the token's own workspace
+	RawResponse string       //extended attribute
+	SCIMURL     string       // base URL of the SCIM API
+
+	appConfigToken string       // app configuration token, used for the apps.manifest methods
+	scimHTTPClient *http.Client // HTTP client for the SCIM API, read-only mode is checked per request
+	scimToken      string       // SCIM API token
+	token          string       // Slack Web API token, used for methods the Slack API client does not cover
 }
 
-// Configure initializes the ConfiguredClient with the Slack API token.
-func (c *slackClient) Configure(ctx context.Context, apiToken string, organization string) diag.Diagnostics {
+// Configure initializes the ConfiguredClient with the Slack API token, API URL and default team ID.
+// Without an API token, only requests that carry their own credentials are sent.
+func (c *slackClient) Configure(ctx context.Context, apiToken string, apiURL string, readOnly bool, teamID string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	// The Slack client expects the API URL to end with a slash
+	c.APIURL = slack.APIURL
+	if apiURL != "" {
+		c.APIURL = strings.TrimSuffix(apiURL, "/") + "/"
+	}
+
+	// Trace every Slack Web API call with tokens redacted
+	var transport http.RoundTripper = &loggingTransport{
+		ctx:  newSlackHTTPLogContext(ctx),
+		next: http.DefaultTransport,
+	}
 	if apiToken == "" {
-		diags.AddError("Missing API Token", "The SLACK_API_TOKEN environment variable or provider configuration is required.")
-		return diags
+		transport = &missingTokenTransport{next: transport}
 	}
 
-	// Initialize the Slack API client
-	c.Client = slack.New(apiToken)
+	c.scimHTTPClient = &http.Client{Transport: transport}
 
-	// Add any additional logic for organization or other setup here
+	c.token = apiToken
+	c.TeamID = teamID
+	c.ReadOnly = readOnly
+	if c.ReadOnly {
+		transport = &readOnlyTransport{next: transport}
+	}
+	c.HTTPClient = &http.Client{Transport: transport}
+
+	// Initialize the Slack API client
+	c.Client = slack.New(apiToken,
+		slack.OptionAPIURL(c.APIURL),
+		slack.OptionHTTPClient(c.HTTPClient),
+	)
 
 	return diags
 }
+
+// CheckWritable adds an error diagnostic and returns false when the provider is in read-only mode.
+func (c *slackClient) CheckWritable(operation string, typeName string, diags *diag.Diagnostics) bool {
+	if !c.ReadOnly {
+		return true
+	}
+
+	diags.AddError(
+		"Provider Is Read-Only",
+		fmt.Sprintf("Cannot %s %s because the Slack provider is configured with read_only = true (or SLACK_READ_ONLY). "+
+			"Disable read-only mode to make changes in Slack.", operation, typeName),
+	)
+	return false
+}
+
+// Call invokes a Slack Web API method the Slack API client does not cover, posting the
+// parameters as a form and decoding the JSON response into result when it is not nil.
+//
+// Errors are returned as the same types the Slack API client uses: slack.SlackErrorResponse
+// for an unsuccessful response, *slack.RateLimitedError when rate limited and
+// slack.StatusCodeError for any other non-200 status. An unsuccessful response is still
+// decoded into result, for methods that describe the error in the response body.
+func (c *slackClient) Call(ctx context.Context, method string, values url.Values, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.APIURL+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.do(req, c.token, method, result)
+}
+
+// CallAppConfiguration invokes a Slack Web API method that takes an app configuration
+// token, such as the apps.manifest methods, falling back to the Web API token when no
+// app configuration token is configured. Responses and errors are handled as in Call.
+func (c *slackClient) CallAppConfiguration(ctx context.Context, method string, values url.Values, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.APIURL+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.do(req, defaultIfEmpty(c.appConfigToken, c.token), method, result)
+}
+
+// CallMultipart invokes a Slack Web API method that takes a file upload, posting the
+// parameters and the file content as a multipart form. Responses and errors are handled
+// as in Call.
+func (c *slackClient) CallMultipart(ctx context.Context, method string, values url.Values, field string, filename string, content []byte, result any) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for key, vals := range values {
+		for _, val := range vals {
+			if err := writer.WriteField(key, val); err != nil {
+				return err
+			}
+		}
+	}
+
+	part, err := writer.CreateFormFile(field, filename)
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(content); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.APIURL+method, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return c.do(req, c.token, method, result)
+}
+
+// do sends a Slack Web API request authenticated with token, when not empty, and decodes the
+// response.
+func (c *slackClient) do(req *http.Request, token string, method string, result any) error {
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter, _ := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		return &slack.RateLimitedError{RetryAfter: time.Duration(retryAfter) * time.Second}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return slack.StatusCodeError{Code: resp.StatusCode, Status: resp.Status}
+	}
+
+	var body json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", method, err)
+	}
+
+	var slackResponse struct {
+		Ok               bool                   `json:"ok"`
+		Error            string                 `json:"error"`
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+	if err := json.Unmarshal(body, &slackResponse); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", method, err)
+	}
+	if result != nil {
+		if err := json.Unmarshal(body, result); err != nil && slackResponse.Ok {
+			return err
+		}
+	}
+	if !slackResponse.Ok {
+		return slack.SlackErrorResponse{Err: slackResponse.Error, ResponseMetadata: slackResponse.ResponseMetadata}
+	}
+	return nil
+}
+
+// ResolveTeamID returns the team ID set on a resource or data source, falling back to the
+// provider default when it is null, unknown or empty.
+func (c *slackClient) ResolveTeamID(teamID types.String) string {
+	if teamID.IsNull() || teamID.IsUnknown() || teamID.ValueString() == "" {
+		return c.TeamID
+	}
+	return teamID.ValueString()
+}
+
+// ResolveUserIDs returns the sorted, unique IDs of users given by email address or ID,
+// looking up email addresses in the provider default workspace.
+func (c *slackClient) ResolveUserIDs(users []string) ([]string, error) {
+	var emails, userIDs []string
+	for _, user := range users {
+		if strings.Contains(user, "@") {
+			emails = append(emails, user)
+		} else {
+			userIDs = append(userIDs, user)
+		}
+	}
+
+	if len(emails) > 0 {
+		found, err := slackutil.GetUserIds(c.Client, emails, c.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, found.IDs...)
+	}
+
+	slices.Sort(userIDs)
+	return slices.Compact(userIDs), nil
+}
+
+// ResolveConversationID returns the ID of a public or private channel given by name, with
+// or without a leading "#", or by ID. IDs are returned as they are.
+func (c *slackClient) ResolveConversationID(channel string) (string, error) {
+	return slackutil.GetConversationId(c.Client, channel, messageConversationTypes, 1000, c.TeamID)
+}
+
+// userGroupIdPattern matches usergroup IDs.
+var userGroupIdPattern = regexp.MustCompile(`^S[A-Z0-9]{8,}$`)
+
+// ResolveUserGroupID returns the ID of a usergroup given by handle, with or without a leading
+// "@", by name or by ID. IDs are returned as they are, as org-level IdP groups are not listed in
+// any workspace until they are linked to it.
+func (c *slackClient) ResolveUserGroupID(usergroup string) (string, error) {
+	if userGroupIdPattern.MatchString(usergroup) {
+		return usergroup, nil
+	}
+
+	return slackutil.GetUserGroupID(c.Client, strings.TrimPrefix(usergroup, "@"), c.TeamID)
+}
+
+// tokenlessMethods are the Slack Web API methods authenticated by their own parameters
+// rather than a token.
+var tokenlessMethods = []string{"oauth.v2.access", "tooling.tokens.rotate"}
+
+// missingTokenTransport rejects the requests that would be sent without a token when the
+// provider has no API token, so that only the resources needing it fail.
+type missingTokenTransport struct {
+	next http.RoundTripper
+}
+
+func (t *missingTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	method := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+	authorization := req.Header.Get("Authorization")
+
+	if slices.Contains(tokenlessMethods, method) {
+		// The Slack API client sends the empty provider token as a bearer token
+		if authorization == "Bearer " {
+			req = req.Clone(req.Context())
+			req.Header.Del("Authorization")
+		}
+		return t.next.RoundTrip(req)
+	}
+
+	if authorization == "" || authorization == "Bearer " {
+		return nil, fmt.Errorf("the Slack provider has no api_token: %q needs a token, set api_token or the SLACK_API_TOKEN environment variable", method)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// readOnlyTransport rejects requests for Slack Web API methods that could mutate Slack
+// before they are sent.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !slackutil.IsReadOnlyMethod(req.URL.Path) {
+		return nil, fmt.Errorf("the Slack provider is in read-only mode: method %q is not permitted", req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])
+	}
+	return t.next.RoundTrip(req)
+}
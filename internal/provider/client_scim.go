@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// scimDefaultURL is the base URL of the Slack SCIM 2.0 API.
+const scimDefaultURL = "https://api.slack.com/scim/v2/"
+
+const (
+	scimSchemaUser    = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup   = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaPatchOp = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// scimError is an unsuccessful response of the SCIM API.
+type scimError struct {
+	Status int
+	Detail string
+}
+
+func (e *scimError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("SCIM request failed with status %d", e.Status)
+	}
+	return fmt.Sprintf("SCIM request failed with status %d: %s", e.Status, e.Detail)
+}
+
+// scimValue is a multi-valued SCIM attribute, such as an email or a group member.
+type scimValue struct {
+	Display string `json:"display,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Value   string `json:"value"`
+}
+
+// scimPatchOperation is a single operation of a SCIM PATCH request.
+type scimPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// scimPatch is the body of a SCIM PATCH request.
+type scimPatch struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+// newSCIMPatch returns a PATCH request body for the operations.
+func newSCIMPatch(operations []scimPatchOperation) scimPatch {
+	return scimPatch{Schemas: []string{scimSchemaPatchOp}, Operations: operations}
+}
+
+// ConfigureSCIM sets the base URL and token used for the SCIM API, falling back to the
+// Slack SCIM API and the Web API token when they are empty.
+func (c *slackClient) ConfigureSCIM(scimURL string, scimToken string) {
+	c.SCIMURL = scimDefaultURL
+	if scimURL != "" {
+		c.SCIMURL = strings.TrimSuffix(scimURL, "/") + "/"
+	}
+
+	c.scimToken = scimToken
+	if c.scimToken == "" {
+		c.scimToken = c.token
+	}
+}
+
+// SCIM sends a request to the SCIM API, encoding body as JSON when it is not nil and
+// decoding the JSON response into result when it is not nil.
+//
+// Unsuccessful responses are returned as *scimError. In read-only mode only GET requests
+// are sent.
+func (c *slackClient) SCIM(ctx context.Context, method string, resourcePath string, body any, result any) error {
+	if c.ReadOnly && method != http.MethodGet {
+		return fmt.Errorf("the Slack provider is in read-only mode: SCIM %s %s is not permitted", method, resourcePath)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.SCIMURL+strings.TrimPrefix(resourcePath, "/"), reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.scimToken)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.scimHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newSCIMError(resp.StatusCode, content)
+	}
+
+	if result == nil || len(content) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(content, result); err != nil {
+		return fmt.Errorf("failed to decode SCIM %s %s response: %w", method, resourcePath, err)
+	}
+	return nil
+}
+
+// newSCIMError builds a scimError from the SCIM 2.0 error body, or the older
+// Slack {"Errors": {...}} form.
+func newSCIMError(status int, content []byte) *scimError {
+	var body struct {
+		Detail string `json:"detail"`
+		Errors struct {
+			Description string `json:"description"`
+		} `json:"Errors"`
+	}
+	_ = json.Unmarshal(content, &body)
+
+	return &scimError{
+		Status: status,
+		Detail: defaultIfEmpty(body.Detail, body.Errors.Description),
+	}
+}
+
+// isSCIMNotFound reports whether err is a SCIM response for a missing user or group.
+func isSCIMNotFound(err error) bool {
+	var scimErr *scimError
+	return errors.As(err, &scimErr) && scimErr.Status == http.StatusNotFound
+}
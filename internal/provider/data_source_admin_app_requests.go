@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adminAppRequest is a pending install request of the admin.apps.requests.list API.
+type adminAppRequest struct {
+	ID  string `json:"id"`
+	App struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"app"`
+	User struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"user"`
+	Team struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	Scopes []struct {
+		Name string `json:"name"`
+	} `json:"scopes"`
+	Message     string `json:"message"`
+	DateCreated int64  `json:"date_created"`
+}
+
+type dataSourceAdminAppRequests struct {
+	client *slackClient
+}
+
+func NewDataSourceAdminAppRequests() datasource.DataSource {
+	return &dataSourceAdminAppRequests{}
+}
+
+func (d *dataSourceAdminAppRequests) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		d.client = providerClient
+	}
+}
+
+func (d *dataSourceAdminAppRequests) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "slack_admin_app_requests"
+}
+
+func (d *dataSourceAdminAppRequests) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var filterEnterpriseId, filterTeamId types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enterprise_id"), &filterEnterpriseId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &filterTeamId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := url.Values{"limit": {"100"}}
+	if !filterEnterpriseId.IsNull() {
+		values.Set("enterprise_id", filterEnterpriseId.ValueString())
+	} else if teamID := d.client.ResolveTeamID(filterTeamId); teamID != "" {
+		values.Set("team_id", teamID)
+	}
+
+	var appRequests []adminAppRequest
+	for {
+		var result struct {
+			AppRequests []adminAppRequest `json:"app_requests"`
+			Metadata    struct {
+				NextCursor string `json:"next_cursor"`
+			} `json:"response_metadata"`
+		}
+		if err := d.client.Call(ctx, "admin.apps.requests.list", values, &result); err != nil {
+			resp.Diagnostics.AddError("Error listing Slack app requests", err.Error())
+			return
+		}
+		appRequests = append(appRequests, result.AppRequests...)
+
+		if result.Metadata.NextCursor == "" {
+			break
+		}
+		values.Set("cursor", result.Metadata.NextCursor)
+	}
+
+	type request struct {
+		AppID       types.String   `tfsdk:"app_id"`
+		AppName     types.String   `tfsdk:"app_name"`
+		DateCreated types.Int64    `tfsdk:"date_created"`
+		ID          types.String   `tfsdk:"id"`
+		Message     types.String   `tfsdk:"message"`
+		Scopes      []types.String `tfsdk:"scopes"`
+		TeamID      types.String   `tfsdk:"team_id"`
+		UserEmail   types.String   `tfsdk:"user_email"`
+		UserID      types.String   `tfsdk:"user_id"`
+		UserName    types.String   `tfsdk:"user_name"`
+	}
+
+	requests := make([]request, 0, len(appRequests))
+	for _, appRequest := range appRequests {
+		scopes := make([]types.String, 0, len(appRequest.Scopes))
+		for _, scope := range appRequest.Scopes {
+			scopes = append(scopes, types.StringValue(scope.Name))
+		}
+		requests = append(requests, request{
+			AppID:       types.StringValue(appRequest.App.ID),
+			AppName:     types.StringValue(appRequest.App.Name),
+			DateCreated: types.Int64Value(appRequest.DateCreated),
+			ID:          types.StringValue(appRequest.ID),
+			Message:     types.StringValue(appRequest.Message),
+			Scopes:      scopes,
+			TeamID:      types.StringValue(appRequest.Team.ID),
+			UserEmail:   types.StringValue(appRequest.User.Email),
+			UserID:      types.StringValue(appRequest.User.ID),
+			UserName:    types.StringValue(appRequest.User.Name),
+		})
+	}
+
+	state := struct {
+		EnterpriseID types.String `tfsdk:"enterprise_id"`
+		Requests     []request    `tfsdk:"requests"`
+		TeamID       types.String `tfsdk:"team_id"`
+	}{
+		EnterpriseID: filterEnterpriseId,
+		Requests:     requests,
+		TeamID:       filterTeamId,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *dataSourceAdminAppRequests) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_admin_app_requests** data source lists the pending requests to install apps in a workspace or an Enterprise Grid organization, for review before they are approved or restricted with a **slack_admin_app_policy** resource.
+
+**Required scopes**
+
+User tokens: admin.apps:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Enterprise Grid organization to list requests in.",
+				Optional:            true,
+			},
+			"requests": schema.ListNestedAttribute{
+				MarkdownDescription: "The pending app install requests.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"app_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the requested app.",
+							Computed:            true,
+						},
+						"app_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the requested app.",
+							Computed:            true,
+						},
+						"date_created": schema.Int64Attribute{
+							MarkdownDescription: "When the request was made, as a Unix timestamp.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the request.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The message of the requesting user.",
+							Computed:            true,
+						},
+						"scopes": schema.ListAttribute{
+							MarkdownDescription: "The scopes the app requests.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the workspace the app is requested for.",
+							Computed:            true,
+						},
+						"user_email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the requesting user.",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the requesting user.",
+							Computed:            true,
+						},
+						"user_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the requesting user.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to list requests in. Defaults to the provider `team_id`.",
+				Optional:            true,
+			},
+		},
+	}
+}
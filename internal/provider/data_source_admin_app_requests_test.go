@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_data_source_slack_admin_app_requests(t *testing.T) {
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"admin.apps.requests.list": func(r *http.Request) string {
+				if r.Form.Get("team_id") != "T0OTHER" {
+					return `{"ok": false, "error": "invalid_team_id"}`
+				}
+				if r.Form.Get("cursor") == "" {
+					return `{"ok": true, "app_requests": [{"id": "Ar0001", "app": {"id": "A0APP", "name": "Poll"}, "user": {"id": "U0ALICE", "name": "alice", "email": "alice@example.com"}, "team": {"id": "T0OTHER"}, "scopes": [{"name": "chat:write"}, {"name": "commands"}], "message": "For standups", "date_created": 1700000000}], "response_metadata": {"next_cursor": "page2"}}`
+				}
+				return `{"ok": true, "app_requests": [{"id": "Ar0002", "app": {"id": "A0OTHER", "name": "Other"}, "user": {"id": "U0BOB"}, "team": {"id": "T0OTHER"}}]}`
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: `
+                    data "slack_admin_app_requests" "test" {
+                        team_id = "T0OTHER"
+                    }
+                `,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_admin_app_requests.test", "requests.#", "2"),
+					resource.TestCheckResourceAttr("data.slack_admin_app_requests.test", "requests.0.app_id", "A0APP"),
+					resource.TestCheckResourceAttr("data.slack_admin_app_requests.test", "requests.0.user_email", "alice@example.com"),
+					resource.TestCheckResourceAttr("data.slack_admin_app_requests.test", "requests.0.scopes.#", "2"),
+					resource.TestCheckResourceAttr("data.slack_admin_app_requests.test", "requests.1.id", "Ar0002"),
+				),
+			},
+		},
+	})
+}
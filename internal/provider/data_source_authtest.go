@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/slack-go/slack"
 )
 
 type AuthTestModel struct {
@@ -18,7 +17,7 @@ type AuthTestModel struct {
 }
 
 type dataSourceAuthtest struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewDataAuthtest() datasource.DataSource {
@@ -32,7 +31,7 @@ func (d *dataSourceAuthtest) Configure(ctx context.Context, req datasource.Confi
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
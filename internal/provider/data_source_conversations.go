@@ -12,7 +12,7 @@ import (
 )
 
 type dataSourceConversations struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewdataSourceConversations() datasource.DataSource {
@@ -26,7 +26,7 @@ func (d *dataSourceConversations) Configure(ctx context.Context, req datasource.
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
@@ -36,9 +36,10 @@ func (d *dataSourceConversations) Metadata(_ context.Context, req datasource.Met
 
 func (d *dataSourceConversations) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state struct {
-		ExcludeArchived types.Bool  `tfsdk:"exclude_archived"`
-		Types           types.List  `tfsdk:"types"`
-		QueryLimit      types.Int64 `tfsdk:"query_limit"`
+		ExcludeArchived types.Bool   `tfsdk:"exclude_archived"`
+		Types           types.List   `tfsdk:"types"`
+		QueryLimit      types.Int64  `tfsdk:"query_limit"`
+		TeamID          types.String `tfsdk:"team_id"`
 		Conversations   []struct {
 			Created            types.Int64  `tfsdk:"created"`
 			Creator            types.String `tfsdk:"creator"`
@@ -122,6 +123,7 @@ func (d *dataSourceConversations) Read(ctx context.Context, req datasource.ReadR
 		ExcludeArchived: state.ExcludeArchived.ValueBool(),
 		Types:           conversationTypes,
 		Limit:           limit,
+		TeamID:          d.client.ResolveTeamID(state.TeamID),
 	}
 
 	var allConversations []slack.Channel
@@ -252,6 +254,10 @@ This limit controls how many items are returned in a single query. Setting a hig
 `,
 				Optional: true,
 			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team (workspace) to list conversations in. Defaults to the provider `team_id`.",
+				Optional:            true,
+			},
 			"types": schema.ListAttribute{
 				MarkdownDescription: `
 Types of conversation to include (e.g., 'public_channel', 'private_channel')."
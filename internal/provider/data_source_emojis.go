@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceEmojis struct {
+	client *slackClient
+}
+
+func NewDataSourceEmojis() datasource.DataSource {
+	return &dataSourceEmojis{}
+}
+
+func (d *dataSourceEmojis) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		d.client = providerClient
+	}
+}
+
+func (d *dataSourceEmojis) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "slack_emojis"
+}
+
+func (d *dataSourceEmojis) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	emojis, err := d.client.GetEmojiContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing Slack emoji", err.Error())
+		return
+	}
+
+	names := make([]types.String, 0, len(emojis))
+	aliases := map[string]types.String{}
+	urls := map[string]types.String{}
+	for name, value := range emojis {
+		names = append(names, types.StringValue(name))
+		if aliasFor, ok := strings.CutPrefix(value, emojiAliasPrefix); ok {
+			aliases[name] = types.StringValue(aliasFor)
+		} else {
+			urls[name] = types.StringValue(value)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].ValueString() < names[j].ValueString()
+	})
+
+	state := struct {
+		Aliases map[string]types.String `tfsdk:"aliases"`
+		Names   []types.String          `tfsdk:"names"`
+		URLs    map[string]types.String `tfsdk:"urls"`
+	}{
+		Aliases: aliases,
+		Names:   names,
+		URLs:    urls,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *dataSourceEmojis) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_emojis** data source lists the custom emoji of the workspace, including aliases.
+
+It can be used to check that an emoji exists before it is used, e.g. the ` + "`status_emoji`" + ` of a **slack_user_status** resource. Standard emoji are not included.
+
+**Required scopes**
+
+Bot tokens: emoji:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"aliases": schema.MapAttribute{
+				MarkdownDescription: "The emoji each alias points to, keyed by alias name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "The names of all custom emoji and aliases, without colons, in alphabetical order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"urls": schema.MapAttribute{
+				MarkdownDescription: "The image URL of each custom emoji, keyed by emoji name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
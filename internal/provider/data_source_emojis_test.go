@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_data_source_slack_emojis(t *testing.T) {
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"emoji.list": func(r *http.Request) string {
+				return `{"ok": true, "emoji": {"shipit": "https://emoji.slack-edge.com/T0TEAM/shipit.png", "squirrel": "alias:shipit"}}`
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: `
+                    data "slack_emojis" "test" {}
+                `,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_emojis.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.slack_emojis.test", "names.0", "shipit"),
+					resource.TestCheckResourceAttr("data.slack_emojis.test", "aliases.squirrel", "shipit"),
+					resource.TestCheckResourceAttr("data.slack_emojis.test", "urls.shipit", "https://emoji.slack-edge.com/T0TEAM/shipit.png"),
+				),
+			},
+		},
+	})
+}
@@ -12,7 +12,7 @@ import (
 )
 
 type dataSourceUser struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewDataSourceUser() datasource.DataSource {
@@ -27,7 +27,7 @@ func (d *dataSourceUser) Configure(ctx context.Context, req datasource.Configure
 				"Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
@@ -51,6 +51,10 @@ User tokens: users:read
 				MarkdownDescription: "ID to lookup.",
 				Required:            true,
 			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team (workspace) to look the user up in. Defaults to the provider `team_id`.",
+				Optional:            true,
+			},
 			"user": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -286,7 +290,7 @@ User tokens: users:read
 }
 
 func (d *dataSourceUser) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var filterId types.String
+	var filterId, filterTeamId types.String
 
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &filterId)
 	resp.Diagnostics.Append(diags...)
@@ -294,7 +298,13 @@ func (d *dataSourceUser) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	users, err := d.client.GetUsers()
+	diags = req.Config.GetAttribute(ctx, path.Root("team_id"), &filterTeamId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.GetUsers(slack.GetUsersOptionTeamID(d.client.ResolveTeamID(filterTeamId)))
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving Slack user", fmt.Sprintf("Error: %s", err.Error()))
 		return
@@ -318,77 +328,16 @@ func (d *dataSourceUser) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	userModel := User{
-		Color:   types.StringValue(foundUser.Color),
-		Deleted: types.BoolValue(foundUser.Deleted),
-		Enterprise: EnterpriseUser{
-			ID:             types.StringValue(foundUser.Enterprise.ID),
-			EnterpriseID:   types.StringValue(foundUser.Enterprise.EnterpriseID),
-			EnterpriseName: types.StringValue(foundUser.Enterprise.EnterpriseName),
-			IsAdmin:        types.BoolValue(foundUser.Enterprise.IsAdmin),
-			IsOwner:        types.BoolValue(foundUser.Enterprise.IsOwner),
-			Teams: func() []types.String {
-				teams := make([]types.String, len(foundUser.Enterprise.Teams))
-				for i, team := range foundUser.Enterprise.Teams {
-					teams[i] = types.StringValue(team)
-				}
-				return teams
-			}(),
-		},
-		Has2FA:            types.BoolValue(foundUser.Has2FA),
-		HasFiles:          types.BoolValue(foundUser.HasFiles),
-		ID:                types.StringValue(foundUser.ID),
-		IsAdmin:           types.BoolValue(foundUser.IsAdmin),
-		IsAppUser:         types.BoolValue(foundUser.IsAppUser),
-		IsBot:             types.BoolValue(foundUser.IsBot),
-		IsInvitedUser:     types.BoolValue(foundUser.IsInvitedUser),
-		IsOwner:           types.BoolValue(foundUser.IsOwner),
-		IsPrimaryOwner:    types.BoolValue(foundUser.IsPrimaryOwner),
-		IsRestricted:      types.BoolValue(foundUser.IsRestricted),
-		IsStranger:        types.BoolValue(foundUser.IsStranger),
-		IsUltraRestricted: types.BoolValue(foundUser.IsUltraRestricted),
-		Locale:            types.StringValue(foundUser.Locale),
-		Name:              types.StringValue(foundUser.Name),
-		Presence:          types.StringValue(foundUser.Presence),
-		Profile: UserProfile{
-			ApiAppID:              types.StringValue(foundUser.Profile.ApiAppID),
-			AvatarHash:            types.StringValue(foundUser.Profile.AvatarHash),
-			BotID:                 types.StringValue(foundUser.Profile.BotID),
-			DisplayName:           types.StringValue(foundUser.Profile.DisplayName),
-			DisplayNameNormalized: types.StringValue(foundUser.Profile.DisplayNameNormalized),
-			Email:                 types.StringValue(foundUser.Profile.Email),
-			FirstName:             types.StringValue(foundUser.Profile.FirstName),
-			Image192:              types.StringValue(foundUser.Profile.Image192),
-			Image24:               types.StringValue(foundUser.Profile.Image24),
-			Image32:               types.StringValue(foundUser.Profile.Image32),
-			Image48:               types.StringValue(foundUser.Profile.Image48),
-			Image512:              types.StringValue(foundUser.Profile.Image512),
-			Image72:               types.StringValue(foundUser.Profile.Image72),
-			ImageOriginal:         types.StringValue(foundUser.Profile.ImageOriginal),
-			LastName:              types.StringValue(foundUser.Profile.LastName),
-			Phone:                 types.StringValue(foundUser.Profile.Phone),
-			RealName:              types.StringValue(foundUser.Profile.RealName),
-			RealNameNormalized:    types.StringValue(foundUser.Profile.RealNameNormalized),
-			Skype:                 types.StringValue(foundUser.Profile.Skype),
-			StatusEmoji:           types.StringValue(foundUser.Profile.StatusEmoji),
-			StatusExpiration:      types.Int64Value(int64(foundUser.Profile.StatusExpiration)),
-			StatusText:            types.StringValue(foundUser.Profile.StatusText),
-			Team:                  types.StringValue(foundUser.Profile.Team),
-			Title:                 types.StringValue(foundUser.Profile.Title),
-		},
-		RealName: types.StringValue(foundUser.RealName),
-		TeamID:   types.StringValue(foundUser.TeamID),
-		TZ:       types.StringValue(foundUser.TZ),
-		TZLabel:  types.StringValue(foundUser.TZLabel),
-		TZOffset: types.Int64Value(int64(foundUser.TZOffset)),
-		Updated:  foundUser.Updated,
-	}
+	userModel := newUserModel(*foundUser)
+
 	state := struct {
-		ID   types.String `tfsdk:"id"`
-		User User         `tfsdk:"user"`
+		ID     types.String `tfsdk:"id"`
+		TeamID types.String `tfsdk:"team_id"`
+		User   User         `tfsdk:"user"`
 	}{
-		ID:   types.StringValue(foundUser.ID),
-		User: userModel,
+		ID:     types.StringValue(foundUser.ID),
+		TeamID: filterTeamId,
+		User:   userModel,
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceUserDnd struct {
+	client *slackClient
+}
+
+func NewDataSourceUserDnd() datasource.DataSource {
+	return &dataSourceUserDnd{}
+}
+
+func (d *dataSourceUserDnd) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data",
+				"Expected *ConfiguredClient but got something else.")
+			return
+		}
+		d.client = providerClient
+	}
+}
+
+func (d *dataSourceUserDnd) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "slack_user_dnd"
+}
+
+func (d *dataSourceUserDnd) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_user_dnd** data source retrieves the do not disturb status of a Slack user by their unique user ID, including their snooze and scheduled do not disturb hours.
+
+**Required scopes**
+
+User tokens: dnd:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID to lookup.",
+				Required:            true,
+			},
+			"dnd_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user has scheduled do not disturb hours.",
+			},
+			"next_dnd_end_ts": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp (epoch) when the next do not disturb period ends.",
+			},
+			"next_dnd_start_ts": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp (epoch) when the next do not disturb period starts.",
+			},
+			"snooze_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user's notifications are snoozed.",
+			},
+			"snooze_endtime": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp (epoch) when the snooze ends.",
+			},
+			"snooze_remaining": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of seconds left in the snooze.",
+			},
+		},
+	}
+}
+
+func (d *dataSourceUserDnd) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var filterId types.String
+
+	diags := req.Config.GetAttribute(ctx, path.Root("id"), &filterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := filterId.ValueString()
+	dnd, err := d.client.GetDNDInfoContext(ctx, &userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack do not disturb status", fmt.Sprintf("Error: %s", err.Error()))
+		return
+	}
+
+	state := struct {
+		ID              types.String `tfsdk:"id"`
+		DndEnabled      types.Bool   `tfsdk:"dnd_enabled"`
+		NextDndEndTs    types.Int64  `tfsdk:"next_dnd_end_ts"`
+		NextDndStartTs  types.Int64  `tfsdk:"next_dnd_start_ts"`
+		SnoozeEnabled   types.Bool   `tfsdk:"snooze_enabled"`
+		SnoozeEndTime   types.Int64  `tfsdk:"snooze_endtime"`
+		SnoozeRemaining types.Int64  `tfsdk:"snooze_remaining"`
+	}{
+		ID:              filterId,
+		DndEnabled:      types.BoolValue(dnd.Enabled),
+		NextDndEndTs:    types.Int64Value(int64(dnd.NextEndTimestamp)),
+		NextDndStartTs:  types.Int64Value(int64(dnd.NextStartTimestamp)),
+		SnoozeEnabled:   types.BoolValue(dnd.SnoozeEnabled),
+		SnoozeEndTime:   types.Int64Value(int64(dnd.SnoozeEndTime)),
+		SnoozeRemaining: types.Int64Value(int64(dnd.SnoozeRemaining)),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
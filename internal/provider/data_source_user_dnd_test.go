@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_data_source_slack_user_dnd(t *testing.T) {
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"dnd.info": func(r *http.Request) string {
+				if r.Form.Get("user") != "U0123456789" {
+					return `{"ok": false, "error": "user_not_found"}`
+				}
+				return `{"ok": true, "dnd_enabled": true, "next_dnd_start_ts": 1900000000, "next_dnd_end_ts": 1900030000, "snooze_enabled": true, "snooze_endtime": 1800000000, "snooze_remaining": 600}`
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: `
+                    data "slack_user_dnd" "test" {
+                        id = "U0123456789"
+                    }
+                `,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_user_dnd.test", "dnd_enabled", "true"),
+					resource.TestCheckResourceAttr("data.slack_user_dnd.test", "next_dnd_start_ts", "1900000000"),
+					resource.TestCheckResourceAttr("data.slack_user_dnd.test", "next_dnd_end_ts", "1900030000"),
+					resource.TestCheckResourceAttr("data.slack_user_dnd.test", "snooze_enabled", "true"),
+					resource.TestCheckResourceAttr("data.slack_user_dnd.test", "snooze_endtime", "1800000000"),
+					resource.TestCheckResourceAttr("data.slack_user_dnd.test", "snooze_remaining", "600"),
+				),
+			},
+		},
+	})
+}
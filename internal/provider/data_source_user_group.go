@@ -12,7 +12,7 @@ import (
 )
 
 type dataSourceUserGroup struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewDataSourceUserGroup() datasource.DataSource {
@@ -26,7 +26,7 @@ func (d *dataSourceUserGroup) Configure(ctx context.Context, req datasource.Conf
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
@@ -61,10 +61,7 @@ func (d *dataSourceUserGroup) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	teamID, ok := slackutil.GetConfigAttribute[types.String](ctx, req.Config, "team_id_filter", &resp.Diagnostics)
-	if !ok {
-		return
-	}
+	teamID, _ := slackutil.GetConfigAttribute[types.String](ctx, req.Config, "team_id_filter", &resp.Diagnostics)
 
 	// Validation: Ensure either id or name is set, but not both
 	if !filterUserGroupId.IsNull() && !filterUserGroupName.IsNull() {
@@ -92,7 +89,7 @@ func (d *dataSourceUserGroup) Read(ctx context.Context, req datasource.ReadReque
 		slack.GetUserGroupsOptionIncludeUsers(includeUsers.ValueBool()),
 		slack.GetUserGroupsOptionIncludeCount(includeCount.ValueBool()),
 		slack.GetUserGroupsOptionIncludeDisabled(includeDisabled.ValueBool()),
-		slack.GetUserGroupsOptionWithTeamID(teamID.ValueString()),
+		slack.GetUserGroupsOptionWithTeamID(d.client.ResolveTeamID(teamID)),
 	)
 
 	if err != nil {
@@ -235,7 +232,7 @@ This flexibility enables targeted queries based on your specific needs.
 				Optional:            true,
 			},
 			"team_id_filter": schema.StringAttribute{
-				MarkdownDescription: "Encoded team id to list user groups in, required if org token is used.",
+				MarkdownDescription: "Encoded team id to list user groups in, required if org token is used. Defaults to the provider `team_id`.",
 				Optional:            true,
 			},
 			"auto_type": schema.StringAttribute{
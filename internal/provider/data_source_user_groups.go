@@ -12,7 +12,7 @@ import (
 )
 
 type dataSourceUserGroups struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewDataSourceUserGroups() datasource.DataSource {
@@ -26,7 +26,7 @@ func (d *dataSourceUserGroups) Configure(ctx context.Context, req datasource.Con
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
@@ -49,7 +49,7 @@ func (d *dataSourceUserGroups) Read(ctx context.Context, req datasource.ReadRequ
 		slack.GetUserGroupsOptionIncludeUsers(includeUsers.ValueBool()),
 		slack.GetUserGroupsOptionIncludeCount(includeCount.ValueBool()),
 		slack.GetUserGroupsOptionIncludeDisabled(includeDisabled.ValueBool()),
-		slack.GetUserGroupsOptionWithTeamID(teamID.ValueString()),
+		slack.GetUserGroupsOptionWithTeamID(d.client.ResolveTeamID(teamID)),
 	)
 
 	if err != nil {
@@ -198,7 +198,7 @@ User tokens: usergroups:read
 			},
 			"team_id_filter": schema.StringAttribute{
 				Optional:    true,
-				Description: "Encoded team id to list user groups in, required if org token is used.",
+				Description: "Encoded team id to list user groups in, required if org token is used. Defaults to the provider `team_id`.",
 			},
 			"user_groups": schema.ListNestedAttribute{
 				Description: "List of Slack user groups.",
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceUserPresence struct {
+	client *slackClient
+}
+
+func NewDataSourceUserPresence() datasource.DataSource {
+	return &dataSourceUserPresence{}
+}
+
+func (d *dataSourceUserPresence) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data",
+				"Expected *ConfiguredClient but got something else.")
+			return
+		}
+		d.client = providerClient
+	}
+}
+
+func (d *dataSourceUserPresence) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "slack_user_presence"
+}
+
+func (d *dataSourceUserPresence) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_user_presence** data source retrieves the presence of a Slack user by their unique user ID.
+
+Slack only returns the connection details (` + "`online`" + `, ` + "`auto_away`" + `, ` + "`manual_away`" + `, ` + "`connection_count`" + ` and ` + "`last_activity`" + `) for the user the API token belongs to.
+
+**Required scopes**
+
+User tokens: users:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID to lookup.",
+				Required:            true,
+			},
+			"auto_away": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user was marked away automatically after being inactive.",
+			},
+			"connection_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of clients the user is connected with.",
+			},
+			"last_activity": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp (epoch) of the user's last activity.",
+			},
+			"manual_away": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user set their presence to away.",
+			},
+			"online": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user is connected to Slack.",
+			},
+			"presence": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The presence of the user, either `active` or `away`.",
+			},
+		},
+	}
+}
+
+func (d *dataSourceUserPresence) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var filterId types.String
+
+	diags := req.Config.GetAttribute(ctx, path.Root("id"), &filterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	presence, err := d.client.GetUserPresenceContext(ctx, filterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack user presence", fmt.Sprintf("Error: %s", err.Error()))
+		return
+	}
+
+	state := struct {
+		ID              types.String `tfsdk:"id"`
+		AutoAway        types.Bool   `tfsdk:"auto_away"`
+		ConnectionCount types.Int64  `tfsdk:"connection_count"`
+		LastActivity    types.Int64  `tfsdk:"last_activity"`
+		ManualAway      types.Bool   `tfsdk:"manual_away"`
+		Online          types.Bool   `tfsdk:"online"`
+		Presence        types.String `tfsdk:"presence"`
+	}{
+		ID:              filterId,
+		AutoAway:        types.BoolValue(presence.AutoAway),
+		ConnectionCount: types.Int64Value(int64(presence.ConnectionCount)),
+		LastActivity:    types.Int64Value(int64(presence.LastActivity)),
+		ManualAway:      types.BoolValue(presence.ManualAway),
+		Online:          types.BoolValue(presence.Online),
+		Presence:        types.StringValue(presence.Presence),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
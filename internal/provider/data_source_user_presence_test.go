@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_data_source_slack_user_presence(t *testing.T) {
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"users.getPresence": func(r *http.Request) string {
+				if r.Form.Get("user") != "U0123456789" {
+					return `{"ok": false, "error": "user_not_found"}`
+				}
+				return `{"ok": true, "presence": "active", "online": true, "auto_away": false, "manual_away": false, "connection_count": 2, "last_activity": 1800000000}`
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: `
+                    data "slack_user_presence" "test" {
+                        id = "U0123456789"
+                    }
+                `,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_user_presence.test", "presence", "active"),
+					resource.TestCheckResourceAttr("data.slack_user_presence.test", "online", "true"),
+					resource.TestCheckResourceAttr("data.slack_user_presence.test", "manual_away", "false"),
+					resource.TestCheckResourceAttr("data.slack_user_presence.test", "connection_count", "2"),
+					resource.TestCheckResourceAttr("data.slack_user_presence.test", "last_activity", "1800000000"),
+				),
+			},
+		},
+	})
+}
@@ -12,7 +12,7 @@ import (
 )
 
 type dataSourceUserProfile struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewDataSourceUserProfile() datasource.DataSource {
@@ -27,7 +27,7 @@ func (d *dataSourceUserProfile) Configure(ctx context.Context, req datasource.Co
 				"Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
@@ -51,6 +51,10 @@ User tokens: users:read
 				MarkdownDescription: "ID to lookup.",
 				Required:            true,
 			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team (workspace) to look the user up in. Defaults to the provider `team_id`.",
+				Optional:            true,
+			},
 			"api_app_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "ID of the associated API app.",
@@ -152,7 +156,7 @@ User tokens: users:read
 }
 
 func (d *dataSourceUserProfile) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var filterId types.String
+	var filterId, filterTeamId types.String
 
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &filterId)
 	resp.Diagnostics.Append(diags...)
@@ -160,7 +164,13 @@ func (d *dataSourceUserProfile) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	users, err := d.client.GetUsers()
+	diags = req.Config.GetAttribute(ctx, path.Root("team_id"), &filterTeamId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.GetUsers(slack.GetUsersOptionTeamID(d.client.ResolveTeamID(filterTeamId)))
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving Slack user", fmt.Sprintf("Error: %s", err.Error()))
 		return
@@ -209,6 +219,7 @@ func (d *dataSourceUserProfile) Read(ctx context.Context, req datasource.ReadReq
 		StatusExpiration      types.Int64  `tfsdk:"status_expiration"`
 		StatusText            types.String `tfsdk:"status_text"`
 		Team                  types.String `tfsdk:"team"`
+		TeamID                types.String `tfsdk:"team_id"`
 		Title                 types.String `tfsdk:"title"`
 	}{
 		ID:                    types.StringValue(foundUser.ID),
@@ -235,6 +246,7 @@ func (d *dataSourceUserProfile) Read(ctx context.Context, req datasource.ReadReq
 		StatusExpiration:      types.Int64Value(int64(foundUser.Profile.StatusExpiration)),
 		StatusText:            types.StringValue(foundUser.Profile.StatusText),
 		Team:                  types.StringValue(foundUser.Profile.Team),
+		TeamID:                filterTeamId,
 		Title:                 types.StringValue(foundUser.Profile.Title),
 	}
 
@@ -12,7 +12,7 @@ import (
 )
 
 type dataSourceUserStatus struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewDataSourceUserStatus() datasource.DataSource {
@@ -27,7 +27,7 @@ func (d *dataSourceUserStatus) Configure(ctx context.Context, req datasource.Con
 				"Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
@@ -51,6 +51,10 @@ User tokens: users:read
 				MarkdownDescription: "ID to lookup.",
 				Required:            true,
 			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team (workspace) to look the user up in. Defaults to the provider `team_id`.",
+				Optional:            true,
+			},
 			"status_emoji": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The displayed emoji that is enabled for the Slack team.",
@@ -68,7 +72,7 @@ User tokens: users:read
 }
 
 func (d *dataSourceUserStatus) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var filterId types.String
+	var filterId, filterTeamId types.String
 
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &filterId)
 	resp.Diagnostics.Append(diags...)
@@ -76,7 +80,13 @@ func (d *dataSourceUserStatus) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	users, err := d.client.GetUsers()
+	diags = req.Config.GetAttribute(ctx, path.Root("team_id"), &filterTeamId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.GetUsers(slack.GetUsersOptionTeamID(d.client.ResolveTeamID(filterTeamId)))
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving Slack user", fmt.Sprintf("Error: %s", err.Error()))
 		return
@@ -105,11 +115,13 @@ func (d *dataSourceUserStatus) Read(ctx context.Context, req datasource.ReadRequ
 		StatusEmoji      types.String `tfsdk:"status_emoji"`
 		StatusExpiration types.Int64  `tfsdk:"status_expiration"`
 		StatusText       types.String `tfsdk:"status_text"`
+		TeamID           types.String `tfsdk:"team_id"`
 	}{
 		ID:               types.StringValue(foundUser.ID),
 		StatusEmoji:      types.StringValue(foundUser.Profile.StatusEmoji),
 		StatusExpiration: types.Int64Value(int64(foundUser.Profile.StatusExpiration)),
 		StatusText:       types.StringValue(foundUser.Profile.StatusText),
+		TeamID:           filterTeamId,
 	}
 
 	diags = resp.State.Set(ctx, &state)
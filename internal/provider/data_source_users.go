@@ -13,7 +13,7 @@ import (
 )
 
 type dataSourceUsers struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewDataSourceUsers() datasource.DataSource {
@@ -28,7 +28,7 @@ func (d *dataSourceUsers) Configure(ctx context.Context, req datasource.Configur
 				"Expected *ConfiguredClient but got something else.")
 			return
 		}
-		d.client = providerClient.Client
+		d.client = providerClient
 	}
 }
 
@@ -60,6 +60,10 @@ User tokens: users:read, users:read.email (required to access the email field)
 				MarkdownDescription: "Name like filter.",
 				Optional:            true,
 			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team (workspace) to list users in. Defaults to the provider `team_id`.",
+				Optional:            true,
+			},
 			"users": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "List of users returned by the filter.",
@@ -298,7 +302,7 @@ User tokens: users:read, users:read.email (required to access the email field)
 }
 
 func (d *dataSourceUsers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var filterEmail, filterName types.String
+	var filterEmail, filterName, filterTeamId types.String
 
 	diags := func(diags diag.Diagnostics) bool {
 		resp.Diagnostics.Append(diags...)
@@ -311,11 +315,14 @@ func (d *dataSourceUsers) Read(ctx context.Context, req datasource.ReadRequest,
 	if diags(req.Config.GetAttribute(ctx, path.Root("name"), &filterName)) {
 		return
 	}
+	if diags(req.Config.GetAttribute(ctx, path.Root("team_id"), &filterTeamId)) {
+		return
+	}
 
 	filterByEmail := !filterEmail.IsNull() && filterEmail.ValueString() != ""
 	filterByName := !filterName.IsNull() && filterName.ValueString() != ""
 
-	users, err := d.client.GetUsers()
+	users, err := d.client.GetUsers(slack.GetUsersOptionTeamID(d.client.ResolveTeamID(filterTeamId)))
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving Slack users", fmt.Sprintf("Error: %s", err.Error()))
 		return
@@ -337,73 +344,7 @@ func (d *dataSourceUsers) Read(ctx context.Context, req datasource.ReadRequest,
 		// Mark that we have found at least one user
 		foundUsers = true
 
-		userModel := User{
-			Color:   types.StringValue(user.Color),
-			Deleted: types.BoolValue(user.Deleted),
-			Enterprise: EnterpriseUser{
-				ID:             types.StringValue(user.Enterprise.ID),
-				EnterpriseID:   types.StringValue(user.Enterprise.EnterpriseID),
-				EnterpriseName: types.StringValue(user.Enterprise.EnterpriseName),
-				IsAdmin:        types.BoolValue(user.Enterprise.IsAdmin),
-				IsOwner:        types.BoolValue(user.Enterprise.IsOwner),
-				Teams: func() []types.String {
-					teams := make([]types.String, len(user.Enterprise.Teams))
-					for i, team := range user.Enterprise.Teams {
-						teams[i] = types.StringValue(team)
-					}
-					return teams
-				}(),
-			},
-			Has2FA:            types.BoolValue(user.Has2FA),
-			HasFiles:          types.BoolValue(user.HasFiles),
-			ID:                types.StringValue(user.ID),
-			IsAdmin:           types.BoolValue(user.IsAdmin),
-			IsAppUser:         types.BoolValue(user.IsAppUser),
-			IsBot:             types.BoolValue(user.IsBot),
-			IsInvitedUser:     types.BoolValue(user.IsInvitedUser),
-			IsOwner:           types.BoolValue(user.IsOwner),
-			IsPrimaryOwner:    types.BoolValue(user.IsPrimaryOwner),
-			IsRestricted:      types.BoolValue(user.IsRestricted),
-			IsStranger:        types.BoolValue(user.IsStranger),
-			IsUltraRestricted: types.BoolValue(user.IsUltraRestricted),
-			Locale:            types.StringValue(user.Locale),
-			Name:              types.StringValue(user.Name),
-			Presence:          types.StringValue(user.Presence),
-			Profile: UserProfile{
-				ApiAppID:              types.StringValue(user.Profile.ApiAppID),
-				AvatarHash:            types.StringValue(user.Profile.AvatarHash),
-				BotID:                 types.StringValue(user.Profile.BotID),
-				DisplayName:           types.StringValue(user.Profile.DisplayName),
-				DisplayNameNormalized: types.StringValue(user.Profile.DisplayNameNormalized),
-				Email:                 types.StringValue(user.Profile.Email),
-				FirstName:             types.StringValue(user.Profile.FirstName),
-				Image192:              types.StringValue(user.Profile.Image192),
-				Image24:               types.StringValue(user.Profile.Image24),
-				Image32:               types.StringValue(user.Profile.Image32),
-				Image48:               types.StringValue(user.Profile.Image48),
-				Image512:              types.StringValue(user.Profile.Image512),
-				Image72:               types.StringValue(user.Profile.Image72),
-				ImageOriginal:         types.StringValue(user.Profile.ImageOriginal),
-				LastName:              types.StringValue(user.Profile.LastName),
-				Phone:                 types.StringValue(user.Profile.Phone),
-				RealName:              types.StringValue(user.Profile.RealName),
-				RealNameNormalized:    types.StringValue(user.Profile.RealNameNormalized),
-				Skype:                 types.StringValue(user.Profile.Skype),
-				StatusEmoji:           types.StringValue(user.Profile.StatusEmoji),
-				StatusExpiration:      types.Int64Value(int64(user.Profile.StatusExpiration)),
-				StatusText:            types.StringValue(user.Profile.StatusText),
-				Team:                  types.StringValue(user.Profile.Team),
-				Title:                 types.StringValue(user.Profile.Title),
-			},
-			RealName: types.StringValue(user.RealName),
-			TeamID:   types.StringValue(user.TeamID),
-			TZ:       types.StringValue(user.TZ),
-			TZLabel:  types.StringValue(user.TZLabel),
-			TZOffset: types.Int64Value(int64(user.TZOffset)),
-			Updated:  user.Updated,
-		}
-
-		userModels = append(userModels, userModel)
+		userModels = append(userModels, newUserModel(user))
 	}
 
 	if !foundUsers {
@@ -412,16 +353,87 @@ func (d *dataSourceUsers) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	state := struct {
-		Email types.String `tfsdk:"email"`
-		Name  types.String `tfsdk:"name"`
-		Users []User       `tfsdk:"users"`
+		Email  types.String `tfsdk:"email"`
+		Name   types.String `tfsdk:"name"`
+		TeamID types.String `tfsdk:"team_id"`
+		Users  []User       `tfsdk:"users"`
 	}{
-		Email: filterEmail,
-		Name:  filterName,
-		Users: userModels,
+		Email:  filterEmail,
+		Name:   filterName,
+		TeamID: filterTeamId,
+		Users:  userModels,
 	}
 
 	if diags(resp.State.Set(ctx, &state)) {
 		return
 	}
 }
+
+// newUserModel decodes a Slack user into the User model of the user data sources.
+func newUserModel(user slack.User) User {
+	return User{
+		Color:   types.StringValue(user.Color),
+		Deleted: types.BoolValue(user.Deleted),
+		Enterprise: EnterpriseUser{
+			ID:             types.StringValue(user.Enterprise.ID),
+			EnterpriseID:   types.StringValue(user.Enterprise.EnterpriseID),
+			EnterpriseName: types.StringValue(user.Enterprise.EnterpriseName),
+			IsAdmin:        types.BoolValue(user.Enterprise.IsAdmin),
+			IsOwner:        types.BoolValue(user.Enterprise.IsOwner),
+			Teams: func() []types.String {
+				teams := make([]types.String, len(user.Enterprise.Teams))
+				for i, team := range user.Enterprise.Teams {
+					teams[i] = types.StringValue(team)
+				}
+				return teams
+			}(),
+		},
+		Has2FA:            types.BoolValue(user.Has2FA),
+		HasFiles:          types.BoolValue(user.HasFiles),
+		ID:                types.StringValue(user.ID),
+		IsAdmin:           types.BoolValue(user.IsAdmin),
+		IsAppUser:         types.BoolValue(user.IsAppUser),
+		IsBot:             types.BoolValue(user.IsBot),
+		IsInvitedUser:     types.BoolValue(user.IsInvitedUser),
+		IsOwner:           types.BoolValue(user.IsOwner),
+		IsPrimaryOwner:    types.BoolValue(user.IsPrimaryOwner),
+		IsRestricted:      types.BoolValue(user.IsRestricted),
+		IsStranger:        types.BoolValue(user.IsStranger),
+		IsUltraRestricted: types.BoolValue(user.IsUltraRestricted),
+		Locale:            types.StringValue(user.Locale),
+		Name:              types.StringValue(user.Name),
+		Presence:          types.StringValue(user.Presence),
+		Profile: UserProfile{
+			ApiAppID:              types.StringValue(user.Profile.ApiAppID),
+			AvatarHash:            types.StringValue(user.Profile.AvatarHash),
+			BotID:                 types.StringValue(user.Profile.BotID),
+			DisplayName:           types.StringValue(user.Profile.DisplayName),
+			DisplayNameNormalized: types.StringValue(user.Profile.DisplayNameNormalized),
+			Email:                 types.StringValue(user.Profile.Email),
+			FirstName:             types.StringValue(user.Profile.FirstName),
+			Image192:              types.StringValue(user.Profile.Image192),
+			Image24:               types.StringValue(user.Profile.Image24),
+			Image32:               types.StringValue(user.Profile.Image32),
+			Image48:               types.StringValue(user.Profile.Image48),
+			Image512:              types.StringValue(user.Profile.Image512),
+			Image72:               types.StringValue(user.Profile.Image72),
+			ImageOriginal:         types.StringValue(user.Profile.ImageOriginal),
+			LastName:              types.StringValue(user.Profile.LastName),
+			Phone:                 types.StringValue(user.Profile.Phone),
+			RealName:              types.StringValue(user.Profile.RealName),
+			RealNameNormalized:    types.StringValue(user.Profile.RealNameNormalized),
+			Skype:                 types.StringValue(user.Profile.Skype),
+			StatusEmoji:           types.StringValue(user.Profile.StatusEmoji),
+			StatusExpiration:      types.Int64Value(int64(user.Profile.StatusExpiration)),
+			StatusText:            types.StringValue(user.Profile.StatusText),
+			Team:                  types.StringValue(user.Profile.Team),
+			Title:                 types.StringValue(user.Profile.Title),
+		},
+		RealName: types.StringValue(user.RealName),
+		TeamID:   types.StringValue(user.TeamID),
+		TZ:       types.StringValue(user.TZ),
+		TZLabel:  types.StringValue(user.TZLabel),
+		TZOffset: types.Int64Value(int64(user.TZOffset)),
+		Updated:  user.Updated,
+	}
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-slack/internal/slackutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = (*ephemeralSlackOAuthV2Access)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure      = (*ephemeralSlackOAuthV2Access)(nil)
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*ephemeralSlackOAuthV2Access)(nil)
+)
+
+type ephemeralSlackOAuthV2Access struct {
+	apiURL     string
+	httpClient *http.Client
+}
+
+type OAuthV2Access struct {
+	AccessToken         types.String `tfsdk:"access_token"`
+	AppID               types.String `tfsdk:"app_id"`
+	BotUserID           types.String `tfsdk:"bot_user_id"`
+	ClientID            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	Code                types.String `tfsdk:"code"`
+	EnterpriseID        types.String `tfsdk:"enterprise_id"`
+	ExpiresIn           types.Int64  `tfsdk:"expires_in"`
+	IsEnterpriseInstall types.Bool   `tfsdk:"is_enterprise_install"`
+	NextRefreshToken    types.String `tfsdk:"next_refresh_token"`
+	RedirectURI         types.String `tfsdk:"redirect_uri"`
+	RefreshToken        types.String `tfsdk:"refresh_token"`
+	Scope               types.String `tfsdk:"scope"`
+	TeamID              types.String `tfsdk:"team_id"`
+	TeamName            types.String `tfsdk:"team_name"`
+	TokenType           types.String `tfsdk:"token_type"`
+}
+
+func NewEphemeralSlackOAuthV2Access() ephemeral.EphemeralResource {
+	return &ephemeralSlackOAuthV2Access{}
+}
+
+func (e *ephemeralSlackOAuthV2Access) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		e.apiURL = providerClient.APIURL
+		e.httpClient = providerClient.HTTPClient
+	}
+}
+
+func (e *ephemeralSlackOAuthV2Access) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "slack_oauth_v2_access"
+}
+
+func (e *ephemeralSlackOAuthV2Access) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_oauth_v2_access** ephemeral resource exchanges an OAuth code or refresh token for a Slack access token.
+
+This resource calls the Slack oauth.v2.access API each time it is opened. The returned tokens are never persisted in the Terraform plan or state, so they can be passed to other providers within the same run.
+
+Exactly one of **code** or **refresh_token** must be set. The exchange is sent to the provider **api_url**, which allows it to be tested against a local stand-in OAuth endpoint.
+`,
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client ID of the Slack app.",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of the Slack app.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "The temporary authorization code returned by the OAuth redirect.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"redirect_uri": schema.StringAttribute{
+				MarkdownDescription: "The redirect URI used in the authorization request, if any.",
+				Optional:            true,
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token to exchange when token rotation is enabled.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The access token issued for the installation.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the installed Slack app.",
+				Computed:            true,
+			},
+			"bot_user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot user of the installation.",
+				Computed:            true,
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Enterprise Grid organization, if any.",
+				Computed:            true,
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds until the access token expires when token rotation is enabled.",
+				Computed:            true,
+			},
+			"is_enterprise_install": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the app was installed at the organization level.",
+				Computed:            true,
+			},
+			"next_refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token to use for the next exchange when token rotation is enabled.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "The comma-separated scopes granted to the access token.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team the app was installed to.",
+				Computed:            true,
+			},
+			"team_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the team the app was installed to.",
+				Computed:            true,
+			},
+			"token_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the access token (e.g. bot).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralSlackOAuthV2Access) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data OAuthV2Access
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// values may not be known until apply
+	if data.Code.IsUnknown() || data.RefreshToken.IsUnknown() {
+		return
+	}
+
+	if data.Code.IsNull() == data.RefreshToken.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("code"),
+			"Invalid OAuth Exchange Configuration",
+			"Exactly one of code or refresh_token must be set.",
+		)
+	}
+}
+
+func (e *ephemeralSlackOAuthV2Access) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data OAuthV2Access
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oauthResponse, err := slackutil.ExchangeOAuthV2Token(ctx, e.httpClient, e.apiURL, slackutil.OAuthV2AccessParameters{
+		ClientID:     data.ClientID.ValueString(),
+		ClientSecret: data.ClientSecret.ValueString(),
+		Code:         data.Code.ValueString(),
+		RedirectURI:  data.RedirectURI.ValueString(),
+		RefreshToken: data.RefreshToken.ValueString(),
+	})
+	if err != nil {
+		if slackError, ok := err.(slack.SlackErrorResponse); ok {
+			resp.Diagnostics.AddError(
+				"Slack OAuth exchange rejected",
+				"The oauth.v2.access request was rejected by Slack: "+slackError.Err,
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error exchanging Slack OAuth token", err.Error())
+		return
+	}
+
+	data.AccessToken = types.StringValue(oauthResponse.AccessToken)
+	data.AppID = types.StringValue(oauthResponse.AppID)
+	data.BotUserID = types.StringValue(oauthResponse.BotUserID)
+	data.EnterpriseID = types.StringValue(oauthResponse.Enterprise.ID)
+	data.ExpiresIn = types.Int64Value(int64(oauthResponse.ExpiresIn))
+	data.IsEnterpriseInstall = types.BoolValue(oauthResponse.IsEnterpriseInstall)
+	data.NextRefreshToken = types.StringValue(oauthResponse.RefreshToken)
+	data.Scope = types.StringValue(oauthResponse.Scope)
+	data.TeamID = types.StringValue(oauthResponse.Team.ID)
+	data.TeamName = types.StringValue(oauthResponse.Team.Name)
+	data.TokenType = types.StringValue(oauthResponse.TokenType)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Slack OAuth token exchanged", map[string]interface{}{
+		"app_id":      oauthResponse.AppID,
+		"bot_user_id": oauthResponse.BotUserID,
+		"team_id":     oauthResponse.Team.ID,
+	})
+}
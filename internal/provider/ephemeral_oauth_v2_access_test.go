@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// newOAuthStandIn starts a local stand-in for the Slack auth.test and oauth.v2.access methods.
+func newOAuthStandIn(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/auth.test", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok": true, "team": "Example", "user": "bot", "team_id": "T0TEAM", "user_id": "U0BOT"}`))
+	})
+	mux.HandleFunc("/api/oauth.v2.access", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != "test-code" {
+			_, _ = w.Write([]byte(`{"ok": false, "error": "invalid_code"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"ok": true,
+			"access_token": "xoxb-test",
+			"token_type": "bot",
+			"scope": "chat:write",
+			"bot_user_id": "U0BOT",
+			"app_id": "A0APP",
+			"team": {"id": "T0TEAM", "name": "Example"}
+		}`))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func Test_ephemeral_slack_oauth_v2_access(t *testing.T) {
+	server := newOAuthStandIn(t)
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+                    terraform {
+                        required_providers {
+                            slack = {
+                                source = "hashicorp.com/tfstack/slack"
+                            }
+                        }
+                    }
+
+                    # no token is needed before the app is installed
+                    provider "slack" {
+                        api_url = "%[1]s/api/"
+                    }
+
+                    provider "slack" {
+                        alias     = "installed"
+                        api_token = ephemeral.slack_oauth_v2_access.test.access_token
+                        api_url   = "%[1]s/api/"
+                    }
+
+                    ephemeral "slack_oauth_v2_access" "test" {
+                        client_id     = "123.456"
+                        client_secret = "secret"
+                        code          = "test-code"
+                    }
+
+                    data "slack_authtest" "test" {
+                        provider = slack.installed
+                    }
+                `, server.URL),
+				Check: resource.TestCheckFunc(func(s *terraform.State) error {
+					// Retrieve the resource state
+					rs, ok := s.RootModule().Resources["data.slack_authtest.test"]
+					if !ok {
+						return fmt.Errorf("resource not found: data.slack_authtest.test")
+					}
+					// Check the properties of the resource
+					if rs.Primary.Attributes["team_id"] != "T0TEAM" {
+						return fmt.Errorf("expected team_id to be 'T0TEAM', got %s", rs.Primary.Attributes["team_id"])
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
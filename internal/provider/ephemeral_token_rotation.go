@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*ephemeralSlackTokenRotation)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*ephemeralSlackTokenRotation)(nil)
+)
+
+type ephemeralSlackTokenRotation struct {
+	client *slack.Client
+}
+
+type TokenRotation struct {
+	ExpiresAt        types.Int64  `tfsdk:"expires_at"`
+	IssuedAt         types.Int64  `tfsdk:"issued_at"`
+	NextRefreshToken types.String `tfsdk:"next_refresh_token"`
+	RefreshToken     types.String `tfsdk:"refresh_token"`
+	TeamID           types.String `tfsdk:"team_id"`
+	Token            types.String `tfsdk:"token"`
+	UserID           types.String `tfsdk:"user_id"`
+}
+
+func NewEphemeralSlackTokenRotation() ephemeral.EphemeralResource {
+	return &ephemeralSlackTokenRotation{}
+}
+
+func (e *ephemeralSlackTokenRotation) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		e.client = providerClient.Client
+	}
+}
+
+func (e *ephemeralSlackTokenRotation) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "slack_token_rotation"
+}
+
+func (e *ephemeralSlackTokenRotation) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_token_rotation** ephemeral resource exchanges a Slack refresh token for a new short-lived access token.
+
+This resource calls the Slack tooling.tokens.rotate API each time it is opened. The returned tokens are never persisted in the Terraform plan or state.
+
+Slack invalidates the supplied refresh token once it has been used, so the **next_refresh_token** value must be written back to your secret store for the next run.
+`,
+		Attributes: map[string]schema.Attribute{
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token used to rotate the token.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The rotated short-lived access token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"next_refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token to use for the next rotation.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team the token belongs to.",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the token belongs to.",
+				Computed:            true,
+			},
+			"issued_at": schema.Int64Attribute{
+				MarkdownDescription: "The timestamp (epoch) when the token was issued.",
+				Computed:            true,
+			},
+			"expires_at": schema.Int64Attribute{
+				MarkdownDescription: "The timestamp (epoch) when the token expires.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralSlackTokenRotation) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TokenRotation
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenResponse, err := e.client.RotateTokensContext(ctx, "", data.RefreshToken.ValueString())
+	if err != nil {
+		if slackError, ok := err.(slack.SlackErrorResponse); ok && slackError.Err == "invalid_refresh_token" {
+			resp.Diagnostics.AddError("Invalid refresh token", "The refresh token is invalid or has already been used.")
+			return
+		}
+		resp.Diagnostics.AddError("Error rotating Slack token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(tokenResponse.Token)
+	data.NextRefreshToken = types.StringValue(tokenResponse.RefreshToken)
+	data.TeamID = types.StringValue(tokenResponse.TeamId)
+	data.UserID = types.StringValue(tokenResponse.UserId)
+	data.IssuedAt = types.Int64Value(int64(tokenResponse.IssuedAt))
+	data.ExpiresAt = types.Int64Value(int64(tokenResponse.ExpiresAt))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Slack token rotated", map[string]interface{}{
+		"team_id":    tokenResponse.TeamId,
+		"user_id":    tokenResponse.UserId,
+		"expires_at": tokenResponse.ExpiresAt,
+	})
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func Test_ephemeral_slack_token_rotation(t *testing.T) {
+	// local stand-in for tooling.tokens.rotate, which is authenticated by the refresh token alone
+	server := newSlackStandIn(t, map[string]func(r *http.Request) string{
+		"tooling.tokens.rotate": func(r *http.Request) string {
+			if r.Header.Get("Authorization") != "" {
+				t.Errorf("expected tooling.tokens.rotate without an Authorization header, got %q", r.Header.Get("Authorization"))
+			}
+			if r.Form.Get("refresh_token") != "xoxe-1-refresh" {
+				return `{"ok": false, "error": "invalid_refresh_token"}`
+			}
+			return `{"ok": true, "token": "xoxe.xoxp-1-rotated", "refresh_token": "xoxe-1-next", "team_id": "T0TEAM", "user_id": "U0BOT", "iat": 1700000000, "exp": 1700043200}`
+		},
+	})
+
+	t.Setenv("SLACK_API_TOKEN", "")
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+                    # no token is needed to rotate the refresh token
+                    provider "slack" {
+                        api_url = "%[1]s/api/"
+                    }
+
+                    provider "slack" {
+                        alias     = "rotated"
+                        api_token = ephemeral.slack_token_rotation.test.token
+                        api_url   = "%[1]s/api/"
+                    }
+
+                    ephemeral "slack_token_rotation" "test" {
+                        refresh_token = "xoxe-1-refresh"
+                    }
+
+                    data "slack_authtest" "test" {
+                        provider = slack.rotated
+                    }
+                `, server.URL),
+				Check: resource.TestCheckResourceAttr("data.slack_authtest.test", "team_id", "T0TEAM"),
+			},
+		},
+	})
+}
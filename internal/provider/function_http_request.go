@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"terraform-provider-slack/internal/slackutil"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -106,6 +107,12 @@ func (f *HttpRequestFunction) Run(ctx context.Context, req function.RunRequest,
 		httpReq.Header.Set(key, value)
 	}
 
+	tflog.Debug(ctx, "Executing HTTP request", map[string]interface{}{
+		"url":     slackutil.RedactString(inputs.URL),
+		"method":  inputs.Method,
+		"headers": slackutil.RedactHeaders(inputs.Headers),
+	})
+
 	httpResp, err := retryClient.Do(httpReq)
 	if err != nil {
 		resp.Error = function.NewArgumentFuncError(2, "Failed to execute HTTP request")
@@ -128,6 +135,11 @@ func (f *HttpRequestFunction) Run(ctx context.Context, req function.RunRequest,
 		}
 	}
 
+	tflog.Debug(ctx, "HTTP request completed", map[string]interface{}{
+		"url":         slackutil.RedactString(inputs.URL),
+		"status_code": httpResp.StatusCode,
+	})
+
 	timestamp := time.Now().Format(time.RFC3339)
 	httpResponseObj, diags := types.ObjectValue(
 		httpRequestReturnAttrTypes,
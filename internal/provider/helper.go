@@ -1,8 +1,55 @@
 package provider
 
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/slack-go/slack"
+)
+
 func defaultIfEmpty(s string, defaultVal string) string {
 	if s == "" {
 		return defaultVal
 	}
 	return s
 }
+
+// isSlackError reports whether err is a Slack Web API error with one of the given error codes.
+func isSlackError(err error, codes ...string) bool {
+	var slackError slack.SlackErrorResponse
+	if !errors.As(err, &slackError) {
+		return false
+	}
+	return slices.Contains(codes, slackError.Err)
+}
+
+// requiresReplaceUnlessResolvesTo returns a plan modifier that requires replacement when a name
+// or ID attribute changes, unless the planned value resolves to the ID at idPath in the state.
+// An import only knows the ID, so a config naming the same object is then updated in place.
+func requiresReplaceUnlessResolvesTo(client **slackClient, idPath path.Path, resolve func(c *slackClient, value string) (string, error)) planmodifier.String {
+	description := "Changing the value replaces the resource, unless it still refers to the same object."
+
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = true
+
+			var id types.String
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, idPath, &id)...)
+			// the client is not configured yet when the provider configuration is unknown
+			if resp.Diagnostics.HasError() || id.IsNull() || req.PlanValue.IsUnknown() || *client == nil {
+				return
+			}
+
+			if resolved, err := resolve(*client, req.PlanValue.ValueString()); err == nil && resolved == id.ValueString() {
+				resp.RequiresReplace = false
+			}
+		},
+		description,
+		description,
+	)
+}
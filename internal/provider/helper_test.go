@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func Test_requiresReplaceUnlessResolvesTo(t *testing.T) {
+	ok := func(r *http.Request) string { return `{"ok": true}` }
+	conversations := func(r *http.Request) string {
+		return `{"ok": true, "channels": [{"id": "C0GENERAL", "name": "general", "is_channel": true}], "response_metadata": {"next_cursor": ""}}`
+	}
+
+	// each resource is imported by ID with a config naming the same object, which is updated in place
+	tests := []struct {
+		name      string
+		address   string
+		importID  string
+		attribute string
+		value     string
+		config    string
+		handlers  map[string]func(r *http.Request) string
+	}{
+		{
+			name:      "message",
+			address:   "slack_message.test",
+			importID:  "C0GENERAL/1700000000.000100",
+			attribute: "channel",
+			value:     "general",
+			config: `
+                resource "slack_message" "test" {
+                    channel = "general"
+                    text    = "How to get help: ask in #help"
+                }
+            `,
+			handlers: map[string]func(r *http.Request) string{
+				"conversations.list": conversations,
+				"conversations.history": func(r *http.Request) string {
+					return `{"ok": true, "messages": [{"type": "message", "ts": "1700000000.000100", "text": "How to get help: ask in #help"}]}`
+				},
+				"chat.update": ok,
+				"chat.delete": ok,
+			},
+		},
+		{
+			name:      "conversation bookmark",
+			address:   "slack_conversation_bookmark.test",
+			importID:  "C0GENERAL/Bk0RUNBOOK",
+			attribute: "channel",
+			value:     "#general",
+			config: `
+                resource "slack_conversation_bookmark" "test" {
+                    channel = "#general"
+                    title   = "Runbook"
+                    link    = "https://example.com/runbook"
+                }
+            `,
+			handlers: map[string]func(r *http.Request) string{
+				"conversations.list": conversations,
+				"bookmarks.list": func(r *http.Request) string {
+					return `{"ok": true, "bookmarks": [{"id": "Bk0RUNBOOK", "channel_id": "C0GENERAL", "title": "Runbook", "link": "https://example.com/runbook", "type": "link"}]}`
+				},
+				"bookmarks.edit": func(r *http.Request) string {
+					return `{"ok": true, "bookmark": {"id": "Bk0RUNBOOK", "channel_id": "C0GENERAL", "title": "Runbook", "link": "https://example.com/runbook", "type": "link"}}`
+				},
+				"bookmarks.remove": ok,
+			},
+		},
+		{
+			name:      "conversation pins",
+			address:   "slack_conversation_pins.test",
+			importID:  "C0GENERAL",
+			attribute: "channel",
+			value:     "general",
+			config: `
+                resource "slack_conversation_pins" "test" {
+                    channel            = "general"
+                    authoritative      = true
+                    message_timestamps = ["1700000000.000100"]
+                }
+            `,
+			handlers: map[string]func(r *http.Request) string{
+				"conversations.list": conversations,
+				"pins.list": func(r *http.Request) string {
+					return `{"ok": true, "items": [{"type": "message", "channel": "C0GENERAL", "message": {"type": "message", "ts": "1700000000.000100"}}]}`
+				},
+				"pins.add":    ok,
+				"pins.remove": ok,
+			},
+		},
+		{
+			name:      "conversation retention",
+			address:   "slack_conversation_retention.test",
+			importID:  "C0GENERAL",
+			attribute: "channel",
+			value:     "general",
+			config: `
+                resource "slack_conversation_retention" "test" {
+                    channel       = "general"
+                    duration_days = 365
+                }
+            `,
+			handlers: map[string]func(r *http.Request) string{
+				"conversations.list": conversations,
+				"admin.conversations.getCustomRetention": func(r *http.Request) string {
+					return `{"ok": true, "is_policy_enabled": true, "duration_days": 365}`
+				},
+				"admin.conversations.setCustomRetention":    ok,
+				"admin.conversations.removeCustomRetention": ok,
+			},
+		},
+		{
+			name:      "admin user group link",
+			address:   "slack_admin_user_group_link.test",
+			importID:  "S0ENGINEERS",
+			attribute: "usergroup",
+			value:     "@engineering",
+			config: `
+                resource "slack_admin_user_group_link" "test" {
+                    usergroup   = "@engineering"
+                    team_ids    = ["T0ONE"]
+                    channel_ids = ["C0GENERAL"]
+                }
+            `,
+			handlers: map[string]func(r *http.Request) string{
+				"usergroups.list": func(r *http.Request) string {
+					return `{"ok": true, "usergroups": [{"id": "S0ENGINEERS", "name": "Engineering", "handle": "engineering"}]}`
+				},
+				"admin.usergroups.listChannels": func(r *http.Request) string {
+					return `{"ok": true, "channels": [{"id": "C0GENERAL"}]}`
+				},
+				"admin.usergroups.addTeams":       ok,
+				"admin.usergroups.addChannels":    ok,
+				"admin.usergroups.removeChannels": ok,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runSlackStandInTest(t, slackStandInTest{
+				handlers: test.handlers,
+				steps: []resource.TestStep{
+					{
+						Config: test.config + `
+                            import {
+                                to = ` + test.address + `
+                                id = "` + test.importID + `"
+                            }
+                        `,
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectResourceAction(test.address, plancheck.ResourceActionUpdate),
+							},
+						},
+						Check: resource.TestCheckResourceAttr(test.address, test.attribute, test.value),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"terraform-provider-slack/internal/slackutil"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// slackHTTPLogSubsystem is the tflog subsystem used to trace Slack Web API calls.
+// Its level can be set independently with the TF_LOG_PROVIDER_SLACK_HTTP environment variable.
+const slackHTTPLogSubsystem = "slack_http"
+
+// rateLimitHeaders lists the response headers Slack uses to report rate limiting.
+var rateLimitHeaders = []string{
+	"Retry-After",
+	"X-Rate-Limit-Limit",
+	"X-Rate-Limit-Remaining",
+	"X-Rate-Limit-Reset",
+}
+
+// newSlackHTTPLogContext registers the Slack HTTP logging subsystem on the context, keeping
+// the fields of the root logger such as the resource type, and masking any Slack token that
+// would otherwise end up in a log field.
+func newSlackHTTPLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, slackHTTPLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_SLACK_HTTP"), tflog.WithRootFields())
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, slackHTTPLogSubsystem, slackutil.TokenPattern)
+	return ctx
+}
+
+// loggingTransport logs each Slack Web API call through the slack_http tflog subsystem,
+// with the context of the request so that the fields of the calling operation are kept.
+//
+// The method, status, rate-limit headers and latency are logged at DEBUG, while the
+// redacted request parameters and headers are logged at TRACE.
+type loggingTransport struct {
+	ctx  context.Context // used for requests without a context, as sent by the Slack API client methods without a Context suffix
+	next http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	method := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+
+	ctx := t.ctx
+	if req.Context() != context.Background() {
+		ctx = newSlackHTTPLogContext(req.Context())
+	}
+
+	parameters, err := requestParameters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemTrace(ctx, slackHTTPLogSubsystem, "Sending Slack API request", map[string]interface{}{
+		"slack_method": method,
+		"http_method":  req.Method,
+		"parameters":   parameters,
+		"headers":      slackutil.RedactHTTPHeaders(req.Header),
+	})
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, slackHTTPLogSubsystem, "Slack API request failed", map[string]interface{}{
+			"slack_method": method,
+			"latency_ms":   latency.Milliseconds(),
+			"error":        slackutil.RedactString(err.Error()),
+		})
+		return nil, err
+	}
+
+	fields := map[string]interface{}{
+		"slack_method": method,
+		"http_status":  resp.StatusCode,
+		"latency_ms":   latency.Milliseconds(),
+	}
+	for _, header := range rateLimitHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			fields[strings.ToLower(header)] = value
+		}
+	}
+
+	slackErr, err := responseError(resp)
+	if err != nil {
+		return nil, err
+	}
+	if slackErr != "" {
+		fields["slack_error"] = slackErr
+	}
+
+	tflog.SubsystemDebug(ctx, slackHTTPLogSubsystem, "Received Slack API response", fields)
+
+	return resp, nil
+}
+
+// requestParameters returns the redacted query and form parameters of a request,
+// restoring the request body so it can still be sent.
+func requestParameters(req *http.Request) (map[string]string, error) {
+	values := req.URL.Query()
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if req.Body != nil && req.Body != http.NoBody && mediaType == "application/x-www-form-urlencoded" {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		form, err := url.ParseQuery(string(body))
+		if err == nil {
+			for key, value := range form {
+				values[key] = append(values[key], value...)
+			}
+		}
+	}
+
+	return slackutil.RedactValues(values), nil
+}
+
+// responseError returns the error code of an unsuccessful Slack JSON response,
+// restoring the response body so it can still be decoded by the caller.
+func responseError(resp *http.Response) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if resp.Body == nil || mediaType != "application/json" {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var slackResponse struct {
+		Ok    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &slackResponse); err != nil || slackResponse.Ok {
+		return "", nil
+	}
+
+	return slackResponse.Error, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -15,13 +17,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+var (
+	_ provider.Provider                       = (*slackProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*slackProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*slackProvider)(nil)
+)
+
 type slackProvider struct {
 	version string
 	client  *slackClient
 }
 
 type slackProviderModel struct {
-	ApiToken types.String `tfsdk:"api_token"`
+	ApiToken              types.String `tfsdk:"api_token"`
+	ApiURL                types.String `tfsdk:"api_url"`
+	AppConfigurationToken types.String `tfsdk:"app_configuration_token"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+	SCIMToken             types.String `tfsdk:"scim_token"`
+	SCIMURL               types.String `tfsdk:"scim_url"`
+	TeamID                types.String `tfsdk:"team_id"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -45,10 +59,36 @@ The Slack provider enables interaction with Slack resources. Configure the provi
 `,
 		Attributes: map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "The Slack Web API token used for authentication",
-				Required:            true,
+				MarkdownDescription: "The Slack Web API token used for authentication. May be set from an ephemeral value or with the `SLACK_API_TOKEN` environment variable. Without it, only the ephemeral resources and the resources using `app_configuration_token` or `scim_token` can be used.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the Slack Web API. Defaults to `https://slack.com/api/`. May also be set with the `SLACK_API_URL` environment variable, for example to point the provider at a local stand-in during testing.",
+				Optional:            true,
+			},
+			"app_configuration_token": schema.StringAttribute{
+				MarkdownDescription: "The app configuration token used for the apps.manifest methods by the `slack_app_manifest` resource. Defaults to `api_token`. May also be set with the `SLACK_APP_CONFIGURATION_TOKEN` environment variable.",
+				Optional:            true,
 				Sensitive:           true,
 			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, every create, update and delete fails with an error and any Slack Web API method that could mutate Slack is rejected before it is sent. Useful for drift-detection plans. May also be set with the `SLACK_READ_ONLY` environment variable.",
+				Optional:            true,
+			},
+			"scim_token": schema.StringAttribute{
+				MarkdownDescription: "The token used for the SCIM API by the `slack_scim_*` resources, which needs the `admin` scope of an Enterprise organization owner. Defaults to `api_token`. May also be set with the `SLACK_SCIM_TOKEN` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"scim_url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the SCIM 2.0 API. Defaults to `https://api.slack.com/scim/v2/`. May also be set with the `SLACK_SCIM_URL` environment variable, for example to point the provider at a local stand-in during testing.",
+				Optional:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The default workspace (team) ID for Enterprise Grid organizations. It is passed to the conversations, usergroups and users calls of every resource and data source that does not set its own `team_id`. Required when using an org-level token. May also be set with the `SLACK_TEAM_ID` environment variable.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -67,48 +107,123 @@ func (p *slackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		apiToken = os.Getenv("SLACK_API_TOKEN")
 	}
 
-	if apiToken == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Missing Slack API Token",
-			"The Slack provider cannot create the API client because the Slack Web API token is missing or empty. "+
-				"Please set the token value in the provider configuration or use the SLACK_API_TOKEN environment variable.",
-		)
-		p.client = nil
-		return
+	apiURL := config.ApiURL.ValueString()
+
+	if apiURL == "" {
+		apiURL = os.Getenv("SLACK_API_URL")
+	}
+
+	readOnly := config.ReadOnly.ValueBool()
+
+	if config.ReadOnly.IsNull() && os.Getenv("SLACK_READ_ONLY") != "" {
+		envReadOnly, err := strconv.ParseBool(os.Getenv("SLACK_READ_ONLY"))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid SLACK_READ_ONLY Value",
+				fmt.Sprintf("The SLACK_READ_ONLY environment variable must be a boolean value: %s", err.Error()),
+			)
+			return
+		}
+		readOnly = envReadOnly
+	}
+
+	teamID := config.TeamID.ValueString()
+
+	if teamID == "" {
+		teamID = os.Getenv("SLACK_TEAM_ID")
+	}
+
+	scimURL := config.SCIMURL.ValueString()
+
+	if scimURL == "" {
+		scimURL = os.Getenv("SLACK_SCIM_URL")
+	}
+
+	scimToken := config.SCIMToken.ValueString()
+
+	if scimToken == "" {
+		scimToken = os.Getenv("SLACK_SCIM_TOKEN")
+	}
+
+	appConfigToken := config.AppConfigurationToken.ValueString()
+
+	if appConfigToken == "" {
+		appConfigToken = os.Getenv("SLACK_APP_CONFIGURATION_TOKEN")
 	}
 
 	p.client = &slackClient{}
-	diags = p.client.Configure(ctx, apiToken, "")
+	diags = p.client.Configure(ctx, apiToken, apiURL, readOnly, teamID)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	p.client.ConfigureSCIM(scimURL, scimToken)
+	p.client.appConfigToken = appConfigToken
 
-	authTestResp, err := p.client.AuthTest()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to connect to Slack API",
-			fmt.Sprintf("Unable to authenticate with the provided Slack token: %s", err.Error()),
-		)
-		return
+	if apiToken == "" {
+		// e.g. an app installation that exchanges an OAuth code for its first token
+		tflog.Info(ctx, "Slack provider has no API token, only methods that do not need it can be called")
+	} else {
+		// auth.test only reads data, so it is permitted in read-only mode
+		authTestResp, err := p.client.AuthTest()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to connect to Slack API",
+				fmt.Sprintf("Unable to authenticate with the provided Slack token: %s", err.Error()),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Slack API connection successful", map[string]any{
+			"team":   authTestResp.Team,
+			"user":   authTestResp.User,
+			"teamID": authTestResp.TeamID,
+			"userID": authTestResp.UserID,
+		})
 	}
 
-	tflog.Info(ctx, "Slack API connection successful", map[string]any{
-		"team":   authTestResp.Team,
-		"user":   authTestResp.User,
-		"teamID": authTestResp.TeamID,
-		"userID": authTestResp.UserID,
-	})
+	if p.client.TeamID != "" {
+		tflog.Info(ctx, "Slack provider defaults to team", map[string]any{
+			"teamID": p.client.TeamID,
+		})
+	}
+
+	if p.client.ReadOnly {
+		tflog.Info(ctx, "Slack provider is in read-only mode, changes to Slack are rejected")
+	}
 
 	resp.DataSourceData = p.client
+	resp.EphemeralResourceData = p.client
 	resp.ResourceData = p.client
 }
 
 func (p *slackProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewResourceSlackAdminAppPolicy,
+		NewResourceSlackAdminConversationSettings,
+		NewResourceSlackAdminRoleAssignment,
+		NewResourceSlackAdminUserGroupLink,
+		NewResourceSlackAppManifest,
+		NewResourceSlackCanvas,
+		NewResourceSlackConversationBookmark,
+		NewResourceSlackConversationPins,
+		NewResourceSlackConversationRetention,
+		NewResourceSlackEmoji,
+		NewResourceSlackGuestExpiration,
+		NewResourceSlackInformationBarrier,
+		NewResourceSlackMessage,
+		NewResourceSlackReminder,
+		NewResourceSlackScheduledMessage,
+		NewResourceSlackSCIMGroup,
+		NewResourceSlackSCIMUser,
+		NewResourceSlackTeamSettings,
 		NewResourceSlackUserGroup,
 		NewResourceSlackUserGroupMember,
+		NewResourceSlackUserDm,
+		NewResourceSlackUserDnd,
+		NewResourceSlackUserPhoto,
+		NewResourceSlackUserPresence,
 		NewResourceSlackUserRealName,
 		NewResourceSlackUserStatus,
 	}
@@ -116,18 +231,29 @@ func (p *slackProvider) Resources(ctx context.Context) []func() resource.Resourc
 
 func (p *slackProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDataSourceAdminAppRequests,
 		NewDataAuthtest,
 		NewdataSourceConversation,
 		NewdataSourceConversations,
+		NewDataSourceEmojis,
 		NewDataSourceUser,
+		NewDataSourceUserDnd,
 		NewDataSourceUserGroup,
 		NewDataSourceUserGroups,
+		NewDataSourceUserPresence,
 		NewDataSourceUserProfile,
 		NewDataSourceUsers,
 		NewDataSourceUserStatus,
 	}
 }
 
+func (p *slackProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewEphemeralSlackOAuthV2Access,
+		NewEphemeralSlackTokenRotation,
+	}
+}
+
 func (p *slackProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewFunctionHttpRequest,
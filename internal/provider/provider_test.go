@@ -1,10 +1,342 @@
 package provider
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"slack": providerserver.NewProtocol6WithError(New("test")()),
 }
+
+// newSlackStandIn starts a local stand-in for the Slack Web API for tests that cannot use a
+// real workspace. auth.test is always answered, and every other method is dispatched by name
+// to handlers, which receive the request with its form parsed and return the JSON response.
+func newSlackStandIn(t *testing.T, handlers map[string]func(r *http.Request) string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		method := strings.TrimPrefix(r.URL.Path, "/api/")
+		if method == "auth.test" {
+			_, _ = w.Write([]byte(`{"ok": true, "team": "Example", "user": "bot", "team_id": "T0TEAM", "user_id": "U0BOT"}`))
+			return
+		}
+
+		handler, ok := handlers[method]
+		if !ok {
+			t.Errorf("unexpected request to %s", method)
+			_, _ = w.Write([]byte(`{"ok": false, "error": "unknown_method"}`))
+			return
+		}
+
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			_ = r.ParseMultipartForm(1 << 20)
+		} else {
+			_ = r.ParseForm()
+		}
+		_, _ = w.Write([]byte(handler(r)))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// slackStandInTest is a resource test run against newSlackStandIn. The provider block
+// pointing at the stand-in, followed by providerConfig, is put in front of the Config of
+// every step, so steps only hold the resources under test.
+type slackStandInTest struct {
+	providerConfig string
+	handlers       map[string]func(r *http.Request) string
+	checkDestroy   resource.TestCheckFunc
+	steps          []resource.TestStep
+}
+
+// runSlackStandInTest starts the stand-in and runs the steps of test against it.
+func runSlackStandInTest(t *testing.T, test slackStandInTest) {
+	t.Helper()
+
+	server := newSlackStandIn(t, test.handlers)
+	provider := fmt.Sprintf(`
+        provider "slack" {
+            api_token = "xoxp-test"
+            api_url   = "%s/api/"
+            %s
+        }
+    `, server.URL, test.providerConfig)
+
+	steps := slices.Clone(test.steps)
+	for i := range steps {
+		if steps[i].Config != "" {
+			steps[i].Config = provider + steps[i].Config
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		CheckDestroy:             test.checkDestroy,
+		Steps:                    steps,
+	})
+}
+
+// testCheckStandIn checks the state kept by a stand-in while holding its lock.
+func testCheckStandIn(mu *sync.Mutex, check func() error) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return check()
+	}
+}
+
+// scimStandIn is a local in-memory stand-in for the SCIM 2.0 API, keeping users and groups
+// as decoded JSON objects keyed by resource type and ID.
+type scimStandIn struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	resources map[string]map[string]map[string]any
+	patches   []map[string]any
+	count     int
+}
+
+// newSCIMStandIn starts a SCIM stand-in serving /scim/v2/Users and /scim/v2/Groups.
+// PATCH supports the add, remove and replace operations used by the provider.
+func newSCIMStandIn(t *testing.T) *scimStandIn {
+	t.Helper()
+
+	s := &scimStandIn{resources: map[string]map[string]map[string]any{"Users": {}, "Groups": {}}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer xoxp-scim" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		kind, id, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/scim/v2/"), "/")
+		store, ok := s.resources[kind]
+		if !ok {
+			t.Errorf("unexpected SCIM request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		object, found := store[id]
+		switch {
+		case r.Method == http.MethodPost && id == "":
+			s.count++
+			body["id"] = fmt.Sprintf("W%04d", s.count)
+			store[body["id"].(string)] = body
+			object, found = body, true
+		case !found:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"schemas": ["urn:ietf:params:scim:api:messages:2.0:Error"], "detail": "Resource not found", "status": "404"}`))
+			return
+		case r.Method == http.MethodDelete:
+			if kind == "Users" {
+				object["active"] = false
+			} else {
+				delete(store, id)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case r.Method == http.MethodPatch:
+			s.patches = append(s.patches, body)
+			for _, operation := range body["Operations"].([]any) {
+				scimStandInPatch(object, operation.(map[string]any))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(object)
+	}))
+	t.Cleanup(s.Server.Close)
+
+	return s
+}
+
+// scimStandInPatch applies a SCIM PATCH operation to an object.
+func scimStandInPatch(object map[string]any, operation map[string]any) {
+	path, _ := operation["path"].(string)
+	switch operation["op"] {
+	case "replace":
+		if parent, child, nested := strings.Cut(path, "."); nested {
+			name, _ := object[parent].(map[string]any)
+			if name == nil {
+				name = map[string]any{}
+			}
+			name[child] = operation["value"]
+			object[parent] = name
+			return
+		}
+		object[path] = operation["value"]
+	case "add":
+		members, _ := object[path].([]any)
+		object[path] = append(members, operation["value"].([]any)...)
+	case "remove":
+		members, _ := object[path].([]any)
+		kept := []any{}
+		for _, member := range members {
+			if !slices.ContainsFunc(operation["value"].([]any), func(removed any) bool {
+				return removed.(map[string]any)["value"] == member.(map[string]any)["value"]
+			}) {
+				kept = append(kept, member)
+			}
+		}
+		object[path] = kept
+	}
+}
+
+func Test_provider_read_only(t *testing.T) {
+	// local stand-in that fails the test if a mutating method ever reaches it
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/auth.test":
+			_, _ = w.Write([]byte(`{"ok": true, "team": "Example", "user": "bot", "team_id": "T0TEAM", "user_id": "U0BOT"}`))
+		default:
+			t.Errorf("unexpected request to %s in read-only mode", r.URL.Path)
+			_, _ = w.Write([]byte(`{"ok": false, "error": "unexpected_request"}`))
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+                    provider "slack" {
+                        api_token = "xoxb-test"
+                        api_url   = "%s/api/"
+                        read_only = true
+                    }
+
+                    resource "slack_user_status" "test" {
+                        id          = "U0123456789"
+                        status_text = "Working from home"
+                    }
+                `, server.URL),
+				ExpectError: regexp.MustCompile(`Provider Is Read-Only`),
+			},
+		},
+	})
+}
+
+func Test_provider_without_api_token(t *testing.T) {
+	// local stand-in that fails the test if a request without a token ever reaches it
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s without a token", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok": false, "error": "not_authed"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("SLACK_API_TOKEN", "")
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+                    provider "slack" {
+                        api_url = "%s/api/"
+                    }
+
+                    resource "slack_user_status" "test" {
+                        id          = "U0123456789"
+                        status_text = "Working from home"
+                    }
+                `, server.URL),
+				ExpectError: regexp.MustCompile(`has no api_token`),
+			},
+		},
+	})
+}
+
+func Test_provider_team_id(t *testing.T) {
+	// local stand-in that records the team_id sent with each conversations.list call
+	var mu sync.Mutex
+	teamIDs := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/auth.test":
+			_, _ = w.Write([]byte(`{"ok": true, "team": "Example", "user": "bot", "team_id": "T0TEAM", "user_id": "U0BOT", "enterprise_id": "E0GRID"}`))
+		case "/api/conversations.list":
+			_ = r.ParseForm()
+			mu.Lock()
+			teamIDs[r.Form.Get("team_id")] = true
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"ok": true, "channels": [{"id": "C0GENERAL", "name": "general", "is_channel": true}], "response_metadata": {"next_cursor": ""}}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			_, _ = w.Write([]byte(`{"ok": false, "error": "unexpected_request"}`))
+		}
+	}))
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+                    provider "slack" {
+                        api_token = "xoxe-test"
+                        api_url   = "%s/api/"
+                        team_id   = "T0WORKSPACE"
+                    }
+
+                    data "slack_conversations" "inherited" {}
+
+                    data "slack_conversations" "override" {
+                        team_id = "T0OTHER"
+                    }
+                `, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_conversations.inherited", "conversations.0.id", "C0GENERAL"),
+					resource.TestCheckResourceAttr("data.slack_conversations.override", "team_id", "T0OTHER"),
+					func(_ *terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						for _, teamID := range []string{"T0WORKSPACE", "T0OTHER"} {
+							if !teamIDs[teamID] {
+								return fmt.Errorf("expected conversations.list to be called with team_id %s, got %v", teamID, teamIDs)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
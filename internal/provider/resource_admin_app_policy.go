@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackAdminAppPolicy)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackAdminAppPolicy)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackAdminAppPolicy)(nil)
+)
+
+// adminAppResolutions are the resolutions of an app, with the admin.apps method setting each.
+var adminAppResolutions = map[string]string{
+	"approved":   "admin.apps.approve",
+	"restricted": "admin.apps.restrict",
+}
+
+type AdminAppPolicy struct {
+	AppID        types.String `tfsdk:"app_id"`
+	EnterpriseID types.String `tfsdk:"enterprise_id"`
+	ID           types.String `tfsdk:"id"`
+	Resolution   types.String `tfsdk:"resolution"`
+	TeamID       types.String `tfsdk:"team_id"`
+}
+
+// adminAppResolution is an app in an admin.apps.approved.list or admin.apps.restricted.list response.
+type adminAppResolution struct {
+	App struct {
+		ID string `json:"id"`
+	} `json:"app"`
+}
+
+type resourceSlackAdminAppPolicy struct {
+	client *slackClient
+}
+
+func NewResourceSlackAdminAppPolicy() resource.Resource {
+	return &resourceSlackAdminAppPolicy{}
+}
+
+func (r *resourceSlackAdminAppPolicy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackAdminAppPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_admin_app_policy"
+}
+
+func (r *resourceSlackAdminAppPolicy) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AdminAppPolicy
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Resolution.IsNull() && !data.Resolution.IsUnknown() {
+		if _, ok := adminAppResolutions[data.Resolution.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resolution"),
+				"Invalid App Resolution",
+				fmt.Sprintf("The resolution must be one of approved, restricted, got %q.", data.Resolution.ValueString()),
+			)
+		}
+	}
+
+	if !data.TeamID.IsNull() && !data.EnterpriseID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enterprise_id"),
+			"Conflicting App Policy Scope",
+			"Only one of team_id and enterprise_id can be set.",
+		)
+	}
+}
+
+func (r *resourceSlackAdminAppPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_admin_app_policy", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminAppPolicy
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Call(ctx, adminAppResolutions[data.Resolution.ValueString()], r.scopeValues(&data), nil); err != nil {
+		resp.Diagnostics.AddError("Error setting Slack app resolution", err.Error())
+		return
+	}
+
+	scopeID := r.scopeID(&data)
+	data.ID = types.StringValue(data.AppID.ValueString() + "/" + scopeID)
+
+	// keep the inherited workspace, so that a later change of the provider default does not move the policy
+	data.TeamID = types.StringNull()
+	if data.EnterpriseID.IsNull() && scopeID != "" {
+		data.TeamID = types.StringValue(scopeID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Set Slack app resolution", map[string]interface{}{
+		"app_id":     data.AppID.ValueString(),
+		"resolution": data.Resolution.ValueString(),
+	})
+}
+
+func (r *resourceSlackAdminAppPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_admin_app_policy", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminAppPolicy
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Call(ctx, "admin.apps.clearResolution", r.scopeValues(&data), nil); err != nil {
+		if isSlackError(err, "app_not_found") {
+			tflog.Warn(ctx, "Slack app not found, assuming its resolution was already cleared", map[string]interface{}{
+				"app_id": data.AppID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error clearing Slack app resolution", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Cleared Slack app resolution", map[string]interface{}{
+		"app_id": data.AppID.ValueString(),
+	})
+}
+
+func (r *resourceSlackAdminAppPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	appID, scopeID, _ := strings.Cut(req.ID, "/")
+	if appID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form app_id/team_id or app_id/enterprise_id, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	switch {
+	case strings.HasPrefix(scopeID, "E"):
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enterprise_id"), scopeID)...)
+	case scopeID != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), scopeID)...)
+	}
+}
+
+func (r *resourceSlackAdminAppPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminAppPolicy
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resolution := ""
+	for _, candidate := range []string{"approved", "restricted"} {
+		appIDs, err := r.listApps(ctx, &data, candidate)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving Slack app resolutions", err.Error())
+			return
+		}
+		if slices.Contains(appIDs, data.AppID.ValueString()) {
+			resolution = candidate
+			break
+		}
+	}
+
+	if resolution == "" {
+		tflog.Warn(ctx, "Slack app has no resolution, removing it from state", map[string]interface{}{
+			"app_id": data.AppID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Resolution = types.StringValue(resolution)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackAdminAppPolicy) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_admin_app_policy** resource approves or restricts the installation of an app in a workspace or an Enterprise Grid organization.
+
+The policy applies to the ` + "`team_id`" + ` or ` + "`enterprise_id`" + ` that is set, falling back to the provider ` + "`team_id`" + `. Destroying the resource clears the resolution, so installing the app requires approval again.
+
+Import is supported using ` + "`app_id/team_id`" + ` or ` + "`app_id/enterprise_id`" + `.
+
+**Required scopes**
+
+User tokens: admin.apps:read, admin.apps:write
+`,
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the app.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Enterprise Grid organization the policy applies to.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The app ID and the workspace or organization ID, separated by a slash.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resolution": schema.StringAttribute{
+				MarkdownDescription: "Whether the app is `approved` or `restricted`.",
+				Required:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the policy applies to. Defaults to the provider `team_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackAdminAppPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_admin_app_policy", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state AdminAppPolicy
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	if err := r.client.Call(ctx, adminAppResolutions[data.Resolution.ValueString()], r.scopeValues(&data), nil); err != nil {
+		resp.Diagnostics.AddError("Error setting Slack app resolution", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack app resolution", map[string]interface{}{
+		"app_id":     data.AppID.ValueString(),
+		"resolution": data.Resolution.ValueString(),
+	})
+}
+
+// listApps pages through the apps with the resolution in the scope of the policy and
+// returns their IDs.
+func (r *resourceSlackAdminAppPolicy) listApps(ctx context.Context, data *AdminAppPolicy, resolution string) ([]string, error) {
+	var appIDs []string
+	cursor := ""
+	for {
+		var result struct {
+			ApprovedApps   []adminAppResolution `json:"approved_apps"`
+			RestrictedApps []adminAppResolution `json:"restricted_apps"`
+			Metadata       struct {
+				NextCursor string `json:"next_cursor"`
+			} `json:"response_metadata"`
+		}
+		values := r.scopeValues(data)
+		values.Del("app_id")
+		values.Set("limit", "100")
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+		if err := r.client.Call(ctx, "admin.apps."+resolution+".list", values, &result); err != nil {
+			return nil, err
+		}
+
+		for _, app := range append(result.ApprovedApps, result.RestrictedApps...) {
+			appIDs = append(appIDs, app.App.ID)
+		}
+
+		cursor = result.Metadata.NextCursor
+		if cursor == "" {
+			return appIDs, nil
+		}
+	}
+}
+
+// scopeID returns the workspace or organization ID the policy applies to.
+func (r *resourceSlackAdminAppPolicy) scopeID(data *AdminAppPolicy) string {
+	if !data.EnterpriseID.IsNull() {
+		return data.EnterpriseID.ValueString()
+	}
+	return r.client.ResolveTeamID(data.TeamID)
+}
+
+// scopeValues returns the app_id and the team_id or enterprise_id parameters of the
+// admin.apps methods.
+func (r *resourceSlackAdminAppPolicy) scopeValues(data *AdminAppPolicy) url.Values {
+	values := url.Values{"app_id": {data.AppID.ValueString()}}
+	switch scopeID := r.scopeID(data); {
+	case !data.EnterpriseID.IsNull():
+		values.Set("enterprise_id", scopeID)
+	case scopeID != "":
+		values.Set("team_id", scopeID)
+	}
+	return values
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_admin_app_policy(t *testing.T) {
+	// local stand-in keeping the resolution of each app in memory, keyed by "team/app"
+	var mu sync.Mutex
+	resolutions := map[string]string{}
+
+	resolve := func(resolution string) func(r *http.Request) string {
+		return func(r *http.Request) string {
+			mu.Lock()
+			defer mu.Unlock()
+			key := r.Form.Get("team_id") + "/" + r.Form.Get("app_id")
+			if resolution == "" {
+				delete(resolutions, key)
+			} else {
+				resolutions[key] = resolution
+			}
+			return `{"ok": true}`
+		}
+	}
+	list := func(resolution string, field string) func(r *http.Request) string {
+		return func(r *http.Request) string {
+			mu.Lock()
+			defer mu.Unlock()
+			apps := []map[string]any{}
+			for key, value := range resolutions {
+				teamID, appID, _ := strings.Cut(key, "/")
+				if value == resolution && teamID == r.Form.Get("team_id") {
+					apps = append(apps, map[string]any{"app": map[string]string{"id": appID}})
+				}
+			}
+			body, _ := json.Marshal(map[string]any{"ok": true, field: apps})
+			return string(body)
+		}
+	}
+
+	checkResolution := func(want string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if got := resolutions["T0TEAM/A0APP"]; got != want {
+				return fmt.Errorf("expected resolution %q, got %q", want, got)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"admin.apps.approve":         resolve("approved"),
+			"admin.apps.restrict":        resolve("restricted"),
+			"admin.apps.clearResolution": resolve(""),
+			"admin.apps.approved.list":   list("approved", "approved_apps"),
+			"admin.apps.restricted.list": list("restricted", "restricted_apps"),
+		},
+		checkDestroy: checkResolution(""),
+		steps: []resource.TestStep{
+			{
+				Config: testSlackAdminAppPolicyConfig("approved"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_app_policy.test", "id", "A0APP/T0TEAM"),
+					checkResolution("approved"),
+				),
+			},
+			{
+				Config: testSlackAdminAppPolicyConfig("restricted"),
+				Check:  checkResolution("restricted"),
+			},
+			{
+				// a resolution changed outside Terraform is set again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					resolutions["T0TEAM/A0APP"] = "approved"
+				},
+				Config: testSlackAdminAppPolicyConfig("restricted"),
+				Check:  checkResolution("restricted"),
+			},
+			{
+				ResourceName:      "slack_admin_app_policy.test",
+				ImportState:       true,
+				ImportStateId:     "A0APP/T0TEAM",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testSlackAdminAppPolicyConfig(resolution string) string {
+	return fmt.Sprintf(`
+        resource "slack_admin_app_policy" "test" {
+            app_id     = "A0APP"
+            team_id    = "T0TEAM"
+            resolution = "%s"
+        }
+    `, resolution)
+}
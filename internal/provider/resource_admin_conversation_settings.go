@@ -0,0 +1,446 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackAdminConversationSettings)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackAdminConversationSettings)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackAdminConversationSettings)(nil)
+)
+
+// adminConversationPrefTypes are the entity types accepted in who_can_post and can_thread.
+var adminConversationPrefTypes = []string{"type", "user", "subteam"}
+
+type AdminConversationSettings struct {
+	CanThread        types.Set    `tfsdk:"can_thread"`
+	Channel          types.String `tfsdk:"channel"`
+	ChannelID        types.String `tfsdk:"channel_id"`
+	DisconnectShared types.Bool   `tfsdk:"disconnect_shared"`
+	ID               types.String `tfsdk:"id"`
+	IsPrivate        types.Bool   `tfsdk:"is_private"`
+	OrgChannel       types.Bool   `tfsdk:"org_channel"`
+	TeamIDs          types.Set    `tfsdk:"team_ids"`
+	WhoCanPost       types.Set    `tfsdk:"who_can_post"`
+}
+
+type resourceSlackAdminConversationSettings struct {
+	client *slackClient
+}
+
+func NewResourceSlackAdminConversationSettings() resource.Resource {
+	return &resourceSlackAdminConversationSettings{}
+}
+
+func (r *resourceSlackAdminConversationSettings) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackAdminConversationSettings) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_admin_conversation_settings"
+}
+
+func (r *resourceSlackAdminConversationSettings) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AdminConversationSettings
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, pref := range map[string]types.Set{"who_can_post": data.WhoCanPost, "can_thread": data.CanThread} {
+		if pref.IsNull() || pref.IsUnknown() {
+			continue
+		}
+		var entries []string
+		resp.Diagnostics.Append(pref.ElementsAs(ctx, &entries, false)...)
+		for _, entry := range entries {
+			entityType, value, ok := strings.Cut(entry, ":")
+			if !ok || value == "" || !slices.Contains(adminConversationPrefTypes, entityType) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Conversation Preference",
+					fmt.Sprintf("Each entry must have the form `type:admin`, `user:U0123456789` or `subteam:S0123456789`, got %q.", entry),
+				)
+			}
+		}
+	}
+
+	if data.OrgChannel.ValueBool() && !data.TeamIDs.IsNull() && !data.TeamIDs.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_ids"),
+			"Conflicting Sharing Settings",
+			"The team_ids cannot be set for an org-wide channel, which is shared to every workspace.",
+		)
+	}
+
+	if !data.OrgChannel.IsNull() && !data.OrgChannel.IsUnknown() && !data.OrgChannel.ValueBool() && data.TeamIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_ids"),
+			"Missing Sharing Settings",
+			"The team_ids must be set when org_channel is false, to share the conversation to those workspaces only.",
+		)
+	}
+}
+
+func (r *resourceSlackAdminConversationSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_admin_conversation_settings", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminConversationSettings
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID, err := r.client.ResolveConversationID(data.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("channel"), "Error Retrieving Conversation", err.Error())
+		return
+	}
+	data.ChannelID = types.StringValue(channelID)
+	data.ID = types.StringValue(channelID)
+
+	if !r.apply(ctx, &data, nil, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Applied Slack admin conversation settings", map[string]interface{}{
+		"channel_id": channelID,
+	})
+}
+
+// Delete only removes the settings from the state, as Slack has no defaults to restore them to.
+func (r *resourceSlackAdminConversationSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_admin_conversation_settings", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminConversationSettings
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Slack admin conversation settings are left in place", map[string]interface{}{
+		"channel_id": data.ChannelID.ValueString(),
+	})
+}
+
+func (r *resourceSlackAdminConversationSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+}
+
+func (r *resourceSlackAdminConversationSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminConversationSettings
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := data.ChannelID.ValueString()
+
+	channel, err := r.client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{ChannelID: channelID})
+	if err != nil {
+		if isSlackError(err, "channel_not_found") {
+			tflog.Warn(ctx, "Slack conversation not found, removing its settings from state", map[string]interface{}{
+				"channel_id": channelID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack conversation", err.Error())
+		return
+	}
+
+	if !data.IsPrivate.IsNull() {
+		data.IsPrivate = types.BoolValue(channel.IsPrivate)
+	}
+	if !data.OrgChannel.IsNull() {
+		data.OrgChannel = types.BoolValue(channel.IsGlobalShared)
+	}
+	// a channel shared externally again must be disconnected on the next apply
+	if data.DisconnectShared.ValueBool() && channel.IsExtShared {
+		data.DisconnectShared = types.BoolValue(false)
+	}
+
+	if !data.WhoCanPost.IsNull() || !data.CanThread.IsNull() {
+		prefs, err := r.conversationPrefs(ctx, channelID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving Slack conversation preferences", err.Error())
+			return
+		}
+		var diags diag.Diagnostics
+		if !data.WhoCanPost.IsNull() {
+			data.WhoCanPost, diags = types.SetValueFrom(ctx, types.StringType, prefs["who_can_post"])
+			resp.Diagnostics.Append(diags...)
+		}
+		if !data.CanThread.IsNull() {
+			data.CanThread, diags = types.SetValueFrom(ctx, types.StringType, prefs["can_thread"])
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
+	if !data.TeamIDs.IsNull() {
+		var result struct {
+			TeamIDs []string `json:"team_ids"`
+		}
+		if err := r.client.Call(ctx, "admin.conversations.getTeams", url.Values{"channel_id": {channelID}}, &result); err != nil {
+			resp.Diagnostics.AddError("Error retrieving Slack conversation workspaces", err.Error())
+			return
+		}
+		var diags diag.Diagnostics
+		data.TeamIDs, diags = types.SetValueFrom(ctx, types.StringType, result.TeamIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackAdminConversationSettings) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_admin_conversation_settings** resource manages the admin settings of an Enterprise Grid conversation: who can post and reply in threads, converting it to private, disconnecting it from Slack Connect and sharing it to several workspaces.
+
+Only the settings that are set are managed. Destroying the resource leaves the settings in place.
+
+Import is supported using the conversation ID.
+
+**Required scopes**
+
+User tokens: admin.conversations:read, admin.conversations:write, channels:read, groups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"can_thread": schema.SetAttribute{
+				MarkdownDescription: "Who can reply in threads, as entries such as `type:admin`, `type:owner`, `user:U0123456789` or `subteam:S0123456789`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the conversation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessResolvesTo(&r.client, path.Root("channel_id"), (*slackClient).ResolveConversationID),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disconnect_shared": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the conversation is disconnected from every Slack Connect organization it is shared with.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, a public channel is converted to a private channel. A private channel cannot be converted back.",
+				Optional:            true,
+			},
+			"org_channel": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the conversation is shared to every workspace of the organization. When `false`, `team_ids` must be set to the workspaces the conversation stays shared to.",
+				Optional:            true,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the workspaces the conversation is shared to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"who_can_post": schema.SetAttribute{
+				MarkdownDescription: "Who can post in the conversation, as entries such as `type:admin`, `type:owner`, `user:U0123456789` or `subteam:S0123456789`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackAdminConversationSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_admin_conversation_settings", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state AdminConversationSettings
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ChannelID = state.ChannelID
+	data.ID = state.ID
+
+	if !r.apply(ctx, &data, &state, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack admin conversation settings", map[string]interface{}{
+		"channel_id": data.ChannelID.ValueString(),
+	})
+}
+
+// apply sends the settings that are set and differ from the prior state, which is nil on create.
+func (r *resourceSlackAdminConversationSettings) apply(ctx context.Context, data *AdminConversationSettings, state *AdminConversationSettings, diags *diag.Diagnostics) bool {
+	channelID := data.ChannelID.ValueString()
+	if state == nil {
+		state = &AdminConversationSettings{}
+	}
+
+	prefs := map[string]string{}
+	for name, pref := range map[string][2]types.Set{
+		"who_can_post": {data.WhoCanPost, state.WhoCanPost},
+		"can_thread":   {data.CanThread, state.CanThread},
+	} {
+		if pref[0].IsNull() || pref[0].Equal(pref[1]) {
+			continue
+		}
+		var entries []string
+		diags.Append(pref[0].ElementsAs(ctx, &entries, false)...)
+		slices.Sort(entries)
+		prefs[name] = strings.Join(entries, ",")
+	}
+	if diags.HasError() {
+		return false
+	}
+
+	if len(prefs) > 0 {
+		encoded, err := json.Marshal(prefs)
+		if err != nil {
+			diags.AddError("Error encoding Slack conversation preferences", err.Error())
+			return false
+		}
+		if err := r.client.Call(ctx, "admin.conversations.setConversationPrefs", url.Values{
+			"channel_id": {channelID},
+			"prefs":      {string(encoded)},
+		}, nil); err != nil {
+			diags.AddError("Error setting Slack conversation preferences", err.Error())
+			return false
+		}
+	}
+
+	if !data.IsPrivate.IsNull() || data.DisconnectShared.ValueBool() {
+		channel, err := r.client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{ChannelID: channelID})
+		if err != nil {
+			diags.AddError("Error retrieving Slack conversation", err.Error())
+			return false
+		}
+
+		switch {
+		case data.IsPrivate.ValueBool() && !channel.IsPrivate:
+			if err := r.client.Call(ctx, "admin.conversations.convertToPrivate", url.Values{"channel_id": {channelID}}, nil); err != nil {
+				diags.AddError("Error converting Slack conversation to private", err.Error())
+				return false
+			}
+		case !data.IsPrivate.IsNull() && !data.IsPrivate.ValueBool() && channel.IsPrivate:
+			diags.AddAttributeError(
+				path.Root("is_private"),
+				"Cannot Convert Conversation",
+				fmt.Sprintf("The conversation %s is private and cannot be converted back to a public channel.", channelID),
+			)
+			return false
+		}
+
+		if data.DisconnectShared.ValueBool() && channel.IsExtShared {
+			if err := r.client.Call(ctx, "admin.conversations.disconnectShared", url.Values{"channel_id": {channelID}}, nil); err != nil {
+				diags.AddError("Error disconnecting shared Slack conversation", err.Error())
+				return false
+			}
+		}
+	}
+
+	orgChannelChanged := !data.OrgChannel.IsNull() && data.OrgChannel.ValueBool() != state.OrgChannel.ValueBool()
+	if (!data.TeamIDs.IsNull() && !data.TeamIDs.Equal(state.TeamIDs)) || orgChannelChanged {
+		var teamIDs []string
+		if !data.TeamIDs.IsNull() {
+			diags.Append(data.TeamIDs.ElementsAs(ctx, &teamIDs, false)...)
+			if diags.HasError() {
+				return false
+			}
+		}
+		slices.Sort(teamIDs)
+
+		values := url.Values{
+			"channel_id":  {channelID},
+			"org_channel": {strconv.FormatBool(data.OrgChannel.ValueBool())},
+		}
+		if len(teamIDs) > 0 {
+			values.Set("target_team_ids", strings.Join(teamIDs, ","))
+		}
+		if r.client.TeamID != "" {
+			values.Set("team_id", r.client.TeamID)
+		}
+		if err := r.client.Call(ctx, "admin.conversations.setTeams", values, nil); err != nil {
+			diags.AddError("Error sharing Slack conversation to workspaces", err.Error())
+			return false
+		}
+	}
+
+	return true
+}
+
+// conversationPrefs returns the who_can_post and can_thread preferences of a conversation as
+// sorted `type:value` entries.
+func (r *resourceSlackAdminConversationSettings) conversationPrefs(ctx context.Context, channelID string) (map[string][]string, error) {
+	var result struct {
+		Prefs map[string]map[string][]string `json:"prefs"`
+	}
+	if err := r.client.Call(ctx, "admin.conversations.getConversationPrefs", url.Values{"channel_id": {channelID}}, &result); err != nil {
+		return nil, err
+	}
+
+	prefs := map[string][]string{}
+	for name, entities := range result.Prefs {
+		entries := []string{}
+		for entityType, values := range entities {
+			for _, value := range values {
+				entries = append(entries, entityType+":"+value)
+			}
+		}
+		slices.Sort(entries)
+		prefs[name] = entries
+	}
+	return prefs, nil
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_admin_conversation_settings(t *testing.T) {
+	// local stand-in keeping the settings of a single channel in memory
+	var mu sync.Mutex
+	isPrivate, isExtShared, orgChannel := false, true, false
+	prefs := map[string]map[string][]string{}
+	teamIDs := []string{"T0TEAM"}
+	disconnects := 0
+
+	check := func(wantPrivate bool, wantDisconnects int, wantTeamIDs ...string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if isPrivate != wantPrivate {
+				return fmt.Errorf("expected is_private %t, got %t", wantPrivate, isPrivate)
+			}
+			if disconnects != wantDisconnects {
+				return fmt.Errorf("expected %d disconnects, got %d", wantDisconnects, disconnects)
+			}
+			if got := slices.Sorted(slices.Values(teamIDs)); !slices.Equal(got, wantTeamIDs) {
+				return fmt.Errorf("expected team IDs %v, got %v", wantTeamIDs, got)
+			}
+			return nil
+		})
+	}
+
+	checkOrgChannel := func(want bool) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if orgChannel != want {
+				return fmt.Errorf("expected org_channel %t, got %t", want, orgChannel)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0GENERAL", "name": "general", "is_channel": true}]}`
+			},
+			"conversations.info": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				body, _ := json.Marshal(map[string]any{"ok": true, "channel": map[string]any{
+					"id": "C0GENERAL", "name": "general", "is_private": isPrivate, "is_ext_shared": isExtShared, "is_global_shared": orgChannel,
+				}})
+				return string(body)
+			},
+			"admin.conversations.setConversationPrefs": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				var set map[string]string
+				if err := json.Unmarshal([]byte(r.Form.Get("prefs")), &set); err != nil {
+					return `{"ok": false, "error": "invalid_prefs"}`
+				}
+				for name, value := range set {
+					entities := map[string][]string{}
+					for _, entry := range strings.Split(value, ",") {
+						entityType, id, _ := strings.Cut(entry, ":")
+						entities[entityType] = append(entities[entityType], id)
+					}
+					prefs[name] = entities
+				}
+				return `{"ok": true}`
+			},
+			"admin.conversations.getConversationPrefs": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				body, _ := json.Marshal(map[string]any{"ok": true, "prefs": prefs})
+				return string(body)
+			},
+			"admin.conversations.convertToPrivate": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				isPrivate = true
+				return `{"ok": true}`
+			},
+			"admin.conversations.disconnectShared": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				isExtShared = false
+				disconnects++
+				return `{"ok": true}`
+			},
+			"admin.conversations.setTeams": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				orgChannel = r.Form.Get("org_channel") == "true"
+				if orgChannel {
+					teamIDs = nil
+				} else {
+					teamIDs = strings.Split(r.Form.Get("target_team_ids"), ",")
+				}
+				return `{"ok": true}`
+			},
+			"admin.conversations.getTeams": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				body, _ := json.Marshal(map[string]any{"ok": true, "team_ids": teamIDs})
+				return string(body)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: testSlackAdminConversationSettingsConfig(`
+                    who_can_post = ["type:admin", "user:U0ALICE"]
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_conversation_settings.test", "id", "C0GENERAL"),
+					resource.TestCheckResourceAttr("slack_admin_conversation_settings.test", "channel_id", "C0GENERAL"),
+					resource.TestCheckResourceAttr("slack_admin_conversation_settings.test", "who_can_post.#", "2"),
+					check(false, 0, "T0TEAM"),
+				),
+			},
+			{
+				Config: testSlackAdminConversationSettingsConfig(`
+                    who_can_post      = ["type:admin"]
+                    can_thread        = ["type:admin"]
+                    is_private        = true
+                    disconnect_shared = true
+                    team_ids          = ["T0TEAM", "T0OTHER"]
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_conversation_settings.test", "who_can_post.#", "1"),
+					resource.TestCheckResourceAttr("slack_admin_conversation_settings.test", "can_thread.#", "1"),
+					check(true, 1, "T0OTHER", "T0TEAM"),
+				),
+			},
+			{
+				// a channel shared externally again is disconnected on the next apply
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					isExtShared = true
+				},
+				Config: testSlackAdminConversationSettingsConfig(`
+                    who_can_post      = ["type:admin"]
+                    can_thread        = ["type:admin"]
+                    is_private        = true
+                    disconnect_shared = true
+                    team_ids          = ["T0TEAM", "T0OTHER"]
+                `),
+				Check: check(true, 2, "T0OTHER", "T0TEAM"),
+			},
+			{
+				Config: testSlackAdminConversationSettingsConfig(`
+                    is_private  = true
+                    org_channel = true
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_conversation_settings.test", "org_channel", "true"),
+					checkOrgChannel(true),
+				),
+			},
+			{
+				// an org-wide channel limited to some workspaces outside Terraform is shared again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					orgChannel = false
+					teamIDs = []string{"T0TEAM"}
+				},
+				Config: testSlackAdminConversationSettingsConfig(`
+                    is_private  = true
+                    org_channel = true
+                `),
+				Check: checkOrgChannel(true),
+			},
+			{
+				Config: testSlackAdminConversationSettingsConfig(`
+                    is_private  = true
+                    org_channel = false
+                    team_ids    = ["T0TEAM"]
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_conversation_settings.test", "org_channel", "false"),
+					checkOrgChannel(false),
+					check(true, 2, "T0TEAM"),
+				),
+			},
+			{
+				Config: testSlackAdminConversationSettingsConfig(`
+                    is_private  = true
+                    org_channel = false
+                `),
+				ExpectError: regexp.MustCompile("Missing Sharing Settings"),
+			},
+			{
+				Config: testSlackAdminConversationSettingsConfig(`
+                    is_private = false
+                `),
+				ExpectError: regexp.MustCompile("cannot be converted back"),
+			},
+		},
+	})
+}
+
+func testSlackAdminConversationSettingsConfig(settings string) string {
+	return fmt.Sprintf(`
+        resource "slack_admin_conversation_settings" "test" {
+            channel = "#general"
+            %s
+        }
+    `, settings)
+}
@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*resourceSlackAdminRoleAssignment)(nil)
+	_ resource.ResourceWithImportState = (*resourceSlackAdminRoleAssignment)(nil)
+)
+
+type AdminRoleAssignment struct {
+	EntityIDs types.Set    `tfsdk:"entity_ids"`
+	ID        types.String `tfsdk:"id"`
+	RoleID    types.String `tfsdk:"role_id"`
+	UserIDs   types.Set    `tfsdk:"user_ids"`
+	Users     types.Set    `tfsdk:"users"`
+}
+
+type resourceSlackAdminRoleAssignment struct {
+	client *slackClient
+}
+
+func NewResourceSlackAdminRoleAssignment() resource.Resource {
+	return &resourceSlackAdminRoleAssignment{}
+}
+
+func (r *resourceSlackAdminRoleAssignment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackAdminRoleAssignment) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_admin_role_assignment"
+}
+
+func (r *resourceSlackAdminRoleAssignment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_admin_role_assignment", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminRoleAssignment
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.apply(ctx, &data, nil, &resp.Diagnostics) {
+		return
+	}
+
+	data.ID = data.RoleID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Created Slack admin role assignment", map[string]interface{}{
+		"role_id": data.RoleID.ValueString(),
+	})
+}
+
+func (r *resourceSlackAdminRoleAssignment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_admin_role_assignment", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminRoleAssignment
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entityIDs, userIDs []string
+	resp.Diagnostics.Append(data.EntityIDs.ElementsAs(ctx, &entityIDs, false)...)
+	resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignments, err := r.listAssignments(ctx, data.RoleID.ValueString(), entityIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack role assignments", err.Error())
+		return
+	}
+
+	for _, entityID := range entityIDs {
+		removed := intersectStrings(userIDs, assignments[entityID])
+		if err := r.assign(ctx, "admin.roles.removeAssignments", data.RoleID.ValueString(), entityID, removed); err != nil {
+			resp.Diagnostics.AddError("Error removing Slack role assignments", err.Error())
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted Slack admin role assignment", map[string]interface{}{
+		"role_id": data.RoleID.ValueString(),
+	})
+}
+
+func (r *resourceSlackAdminRoleAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roleID, entities, ok := strings.Cut(req.ID, "/")
+	if !ok || roleID == "" || entities == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form `role_id/entity_id[,entity_id...]`, got %q.", req.ID),
+		)
+		return
+	}
+
+	entityIDs, diags := types.SetValueFrom(ctx, types.StringType, strings.Split(entities, ","))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_ids"), entityIDs)...)
+}
+
+func (r *resourceSlackAdminRoleAssignment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminRoleAssignment
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entityIDs, users []string
+	resp.Diagnostics.Append(data.EntityIDs.ElementsAs(ctx, &entityIDs, false)...)
+	if !data.Users.IsNull() {
+		resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignments, err := r.listAssignments(ctx, data.RoleID.ValueString(), entityIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack role assignments", err.Error())
+		return
+	}
+
+	// a user counts as assigned only when they hold the role on every entity
+	var assigned, all []string
+	for i, entityID := range entityIDs {
+		all = append(all, assignments[entityID]...)
+		if i == 0 {
+			assigned = assignments[entityID]
+		} else {
+			assigned = intersectStrings(assigned, assignments[entityID])
+		}
+	}
+	slices.Sort(all)
+	all = slices.Compact(all)
+
+	// keep the configured email or ID of each assigned user, and add users assigned
+	// outside Terraform by ID so they are planned for removal
+	var kept, seen []string
+	for _, user := range users {
+		userIDs, err := r.client.ResolveUserIDs([]string{user})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Retrieving UserIds", err.Error())
+			return
+		}
+		seen = append(seen, userIDs...)
+		if slices.Contains(assigned, userIDs[0]) {
+			kept = append(kept, user)
+		}
+	}
+	for _, userID := range all {
+		if !slices.Contains(seen, userID) {
+			kept = append(kept, userID)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Users, diags = types.SetValueFrom(ctx, types.StringType, kept)
+	resp.Diagnostics.Append(diags...)
+	data.UserIDs, diags = types.SetValueFrom(ctx, types.StringType, all)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackAdminRoleAssignment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_admin_role_assignment** resource assigns an Enterprise Grid admin role, such as Channel Manager or Users Admin, to users on a set of entities.
+
+Every user is assigned the role on every entity, which can be the organization, workspaces or channels. The users are authoritative for the entities: users assigned the role on them outside Terraform are removed on the next apply.
+
+Import is supported using ` + "`role_id/entity_id[,entity_id...]`" + `.
+
+**Required scopes**
+
+User tokens: admin.roles:read, admin.roles:write, users:read, users:read.email
+`,
+		Attributes: map[string]schema.Attribute{
+			"entity_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the organization, workspaces or channels to assign the role on.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the role.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the role to assign, such as `Rl0A` for Channel Manager.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the users assigned the role on any of the entities.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "The email addresses or IDs of the users to assign the role to.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackAdminRoleAssignment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_admin_role_assignment", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state AdminRoleAssignment
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.apply(ctx, &data, &state, &resp.Diagnostics) {
+		return
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack admin role assignment", map[string]interface{}{
+		"role_id": data.RoleID.ValueString(),
+	})
+}
+
+// apply assigns the role to the planned users on the planned entities, removing any other
+// users from them, and removes the users of the prior state from entities no longer planned.
+func (r *resourceSlackAdminRoleAssignment) apply(ctx context.Context, data *AdminRoleAssignment, state *AdminRoleAssignment, diags *diag.Diagnostics) bool {
+	var entityIDs, users, previousEntityIDs, previousUserIDs []string
+	diags.Append(data.EntityIDs.ElementsAs(ctx, &entityIDs, false)...)
+	diags.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if state != nil {
+		diags.Append(state.EntityIDs.ElementsAs(ctx, &previousEntityIDs, false)...)
+		diags.Append(state.UserIDs.ElementsAs(ctx, &previousUserIDs, false)...)
+	}
+	if diags.HasError() {
+		return false
+	}
+
+	userIDs, err := r.client.ResolveUserIDs(users)
+	if err != nil {
+		diags.AddError("Error Retrieving UserIds", err.Error())
+		return false
+	}
+
+	roleID := data.RoleID.ValueString()
+	assignments, err := r.listAssignments(ctx, roleID, append(slices.Clone(entityIDs), previousEntityIDs...))
+	if err != nil {
+		diags.AddError("Error retrieving Slack role assignments", err.Error())
+		return false
+	}
+
+	for _, entityID := range entityIDs {
+		var added, removed []string
+		for _, userID := range userIDs {
+			if !slices.Contains(assignments[entityID], userID) {
+				added = append(added, userID)
+			}
+		}
+		for _, userID := range assignments[entityID] {
+			if !slices.Contains(userIDs, userID) {
+				removed = append(removed, userID)
+			}
+		}
+
+		if err := r.assign(ctx, "admin.roles.addAssignments", roleID, entityID, added); err != nil {
+			diags.AddError("Error adding Slack role assignments", err.Error())
+			return false
+		}
+		if err := r.assign(ctx, "admin.roles.removeAssignments", roleID, entityID, removed); err != nil {
+			diags.AddError("Error removing Slack role assignments", err.Error())
+			return false
+		}
+	}
+
+	for _, entityID := range previousEntityIDs {
+		if slices.Contains(entityIDs, entityID) {
+			continue
+		}
+		removed := intersectStrings(previousUserIDs, assignments[entityID])
+		if err := r.assign(ctx, "admin.roles.removeAssignments", roleID, entityID, removed); err != nil {
+			diags.AddError("Error removing Slack role assignments", err.Error())
+			return false
+		}
+	}
+
+	var d diag.Diagnostics
+	data.UserIDs, d = types.SetValueFrom(ctx, types.StringType, userIDs)
+	diags.Append(d...)
+	return !diags.HasError()
+}
+
+// assign adds or removes the role for users on an entity, doing nothing when there are no users.
+func (r *resourceSlackAdminRoleAssignment) assign(ctx context.Context, method string, roleID string, entityID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Changing Slack role assignments", map[string]interface{}{
+		"method":    method,
+		"role_id":   roleID,
+		"entity_id": entityID,
+		"user_ids":  strings.Join(userIDs, ","),
+	})
+
+	return r.client.Call(ctx, method, url.Values{
+		"role_id":    {roleID},
+		"entity_ids": {entityID},
+		"user_ids":   {strings.Join(userIDs, ",")},
+	}, nil)
+}
+
+// listAssignments returns the IDs of the users holding a role, keyed by entity ID.
+func (r *resourceSlackAdminRoleAssignment) listAssignments(ctx context.Context, roleID string, entityIDs []string) (map[string][]string, error) {
+	assignments := map[string][]string{}
+	cursor := ""
+	for {
+		values := url.Values{
+			"role_ids":   {roleID},
+			"entity_ids": {strings.Join(entityIDs, ",")},
+			"limit":      {"200"},
+		}
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+
+		var result struct {
+			RoleAssignments []struct {
+				EntityID string `json:"entity_id"`
+				RoleID   string `json:"role_id"`
+				UserID   string `json:"user_id"`
+			} `json:"role_assignments"`
+			ResponseMetadata struct {
+				NextCursor string `json:"next_cursor"`
+			} `json:"response_metadata"`
+		}
+		if err := r.client.Call(ctx, "admin.roles.listAssignments", values, &result); err != nil {
+			return nil, err
+		}
+
+		for _, assignment := range result.RoleAssignments {
+			if assignment.RoleID == roleID && !slices.Contains(assignments[assignment.EntityID], assignment.UserID) {
+				assignments[assignment.EntityID] = append(assignments[assignment.EntityID], assignment.UserID)
+			}
+		}
+
+		cursor = result.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return assignments, nil
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func Test_resource_slack_admin_role_assignment(t *testing.T) {
+	// local stand-in keeping the role assignments in memory as "entity/user" pairs
+	var mu sync.Mutex
+	assigned := []string{"E0ORG/U0OTHER"}
+
+	change := func(r *http.Request, add bool) string {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Form.Get("role_id") != "Rl0A" {
+			return `{"ok": false, "error": "role_not_found"}`
+		}
+		for _, entityID := range strings.Split(r.Form.Get("entity_ids"), ",") {
+			for _, userID := range strings.Split(r.Form.Get("user_ids"), ",") {
+				pair := entityID + "/" + userID
+				if add {
+					assigned = append(assigned, pair)
+				} else {
+					assigned = slices.DeleteFunc(assigned, func(p string) bool { return p == pair })
+				}
+			}
+		}
+		return `{"ok": true}`
+	}
+
+	checkAssigned := func(want ...string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			got := slices.Sorted(slices.Values(assigned))
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				return fmt.Errorf("expected assignments %v, got %v", want, got)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"users.list": func(r *http.Request) string {
+				return `{"ok": true, "members": [{"id": "U0ALICE", "profile": {"email": "alice@example.com"}}, {"id": "U0BOB", "profile": {"email": "bob@example.com"}}]}`
+			},
+			"admin.roles.addAssignments": func(r *http.Request) string {
+				return change(r, true)
+			},
+			"admin.roles.removeAssignments": func(r *http.Request) string {
+				return change(r, false)
+			},
+			"admin.roles.listAssignments": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				entityIDs := strings.Split(r.Form.Get("entity_ids"), ",")
+				assignments := []map[string]string{}
+				for _, pair := range assigned {
+					entityID, userID, _ := strings.Cut(pair, "/")
+					if slices.Contains(entityIDs, entityID) {
+						assignments = append(assignments, map[string]string{"role_id": "Rl0A", "entity_id": entityID, "user_id": userID})
+					}
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "role_assignments": assignments})
+				return string(body)
+			},
+		},
+		checkDestroy: func(_ *terraform.State) error {
+			return checkAssigned()(nil)
+		},
+		steps: []resource.TestStep{
+			{
+				// the user assigned outside Terraform is removed
+				Config: testSlackAdminRoleAssignmentConfig(`"E0ORG"`, `"alice@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_role_assignment.test", "id", "Rl0A"),
+					resource.TestCheckResourceAttr("slack_admin_role_assignment.test", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("slack_admin_role_assignment.test", "user_ids.*", "U0ALICE"),
+					checkAssigned("E0ORG/U0ALICE"),
+				),
+			},
+			{
+				Config: testSlackAdminRoleAssignmentConfig(`"C0CHANNEL"`, `"alice@example.com", "U0BOB"`),
+				Check:  checkAssigned("C0CHANNEL/U0ALICE", "C0CHANNEL/U0BOB"),
+			},
+			{
+				// an assignment removed outside Terraform is added again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					assigned = []string{"C0CHANNEL/U0ALICE"}
+				},
+				Config: testSlackAdminRoleAssignmentConfig(`"C0CHANNEL"`, `"alice@example.com", "U0BOB"`),
+				Check:  checkAssigned("C0CHANNEL/U0ALICE", "C0CHANNEL/U0BOB"),
+			},
+			{
+				ResourceName:            "slack_admin_role_assignment.test",
+				ImportState:             true,
+				ImportStateId:           "Rl0A/C0CHANNEL",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"users"},
+			},
+		},
+	})
+}
+
+func testSlackAdminRoleAssignmentConfig(entityIDs string, users string) string {
+	return fmt.Sprintf(`
+        resource "slack_admin_role_assignment" "test" {
+            role_id    = "Rl0A"
+            entity_ids = [%s]
+            users      = [%s]
+        }
+    `, entityIDs, users)
+}
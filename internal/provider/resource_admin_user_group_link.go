@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*resourceSlackAdminUserGroupLink)(nil)
+	_ resource.ResourceWithImportState = (*resourceSlackAdminUserGroupLink)(nil)
+)
+
+type AdminUserGroupLink struct {
+	AutoProvision types.Bool   `tfsdk:"auto_provision"`
+	ChannelIDs    types.Set    `tfsdk:"channel_ids"`
+	ID            types.String `tfsdk:"id"`
+	TeamIDs       types.Set    `tfsdk:"team_ids"`
+	Usergroup     types.String `tfsdk:"usergroup"`
+	UsergroupID   types.String `tfsdk:"usergroup_id"`
+}
+
+type resourceSlackAdminUserGroupLink struct {
+	client *slackClient
+}
+
+func NewResourceSlackAdminUserGroupLink() resource.Resource {
+	return &resourceSlackAdminUserGroupLink{}
+}
+
+func (r *resourceSlackAdminUserGroupLink) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackAdminUserGroupLink) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_admin_user_group_link"
+}
+
+func (r *resourceSlackAdminUserGroupLink) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_admin_user_group_link", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminUserGroupLink
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usergroupID, err := r.client.ResolveUserGroupID(data.Usergroup.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("usergroup"), "Error Retrieving User Group", err.Error())
+		return
+	}
+	data.UsergroupID = types.StringValue(usergroupID)
+	data.ID = types.StringValue(usergroupID)
+
+	current, err := r.listChannels(ctx, usergroupID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack user group channels", err.Error())
+		return
+	}
+
+	if !r.apply(ctx, &data, nil, current, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Linked Slack user group", map[string]interface{}{
+		"usergroup_id": usergroupID,
+	})
+}
+
+// Delete removes the default channels, the workspaces stay linked as Slack has no method to
+// remove a usergroup from a workspace.
+func (r *resourceSlackAdminUserGroupLink) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_admin_user_group_link", &resp.Diagnostics) {
+		return
+	}
+
+	var data AdminUserGroupLink
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channelIDs []string
+	if !data.ChannelIDs.IsNull() {
+		resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if len(channelIDs) > 0 {
+		slices.Sort(channelIDs)
+		err := r.client.Call(ctx, "admin.usergroups.removeChannels", url.Values{
+			"usergroup_id": {data.UsergroupID.ValueString()},
+			"channel_ids":  {strings.Join(channelIDs, ",")},
+		}, nil)
+		if err != nil && !isSlackError(err, "no_such_subteam", "subteam_not_found") {
+			resp.Diagnostics.AddError("Error removing Slack user group channels", err.Error())
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Unlinked Slack user group", map[string]interface{}{
+		"usergroup_id": data.UsergroupID.ValueString(),
+	})
+}
+
+func (r *resourceSlackAdminUserGroupLink) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usergroup"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usergroup_id"), req.ID)...)
+}
+
+func (r *resourceSlackAdminUserGroupLink) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminUserGroupLink
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelIDs, err := r.listChannels(ctx, data.UsergroupID.ValueString())
+	if err != nil {
+		if isSlackError(err, "no_such_subteam", "subteam_not_found") {
+			tflog.Warn(ctx, "Slack user group not found, removing it from state", map[string]interface{}{
+				"usergroup_id": data.UsergroupID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack user group channels", err.Error())
+		return
+	}
+
+	if len(channelIDs) > 0 || !data.ChannelIDs.IsNull() {
+		var diags diag.Diagnostics
+		data.ChannelIDs, diags = types.SetValueFrom(ctx, types.StringType, channelIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackAdminUserGroupLink) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_admin_user_group_link** resource links a user group of an Enterprise Grid organization, such as a group provisioned by an IdP, to workspaces and manages its organization-level default channels.
+
+Unlike the ` + "`channels`" + ` of a **slack_user_group**, which are the default channels within one workspace, the ` + "`channel_ids`" + ` apply across the organization. They are authoritative: channels added outside Terraform are removed on the next apply.
+
+Slack has no method to remove a user group from a workspace or to list its workspaces, so workspaces removed from ` + "`team_ids`" + ` stay linked and workspaces linked outside Terraform are not detected.
+
+Import is supported using the user group ID.
+
+**Required scopes**
+
+User tokens: admin.usergroups:read, admin.usergroups:write, usergroups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"auto_provision": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, members of the user group are added to the linked workspaces automatically.",
+				Optional:            true,
+			},
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the organization-level default channels of the user group.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the workspaces the user group is linked to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"usergroup": schema.StringAttribute{
+				MarkdownDescription: "The handle, name or ID of the user group. Groups that are not linked to the provider workspace yet must be given by ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessResolvesTo(&r.client, path.Root("usergroup_id"), (*slackClient).ResolveUserGroupID),
+				},
+			},
+			"usergroup_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackAdminUserGroupLink) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_admin_user_group_link", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state AdminUserGroupLink
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.UsergroupID = state.UsergroupID
+
+	var current []string
+	if !state.ChannelIDs.IsNull() {
+		resp.Diagnostics.Append(state.ChannelIDs.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !r.apply(ctx, &data, &state, current, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack user group link", map[string]interface{}{
+		"usergroup_id": data.UsergroupID.ValueString(),
+	})
+}
+
+// apply links the workspaces that are not in the prior state, which is nil on create, or every
+// planned workspace when auto_provision changes, and adds and removes default channels so that
+// they match the plan.
+func (r *resourceSlackAdminUserGroupLink) apply(ctx context.Context, data *AdminUserGroupLink, state *AdminUserGroupLink, current []string, diags *diag.Diagnostics) bool {
+	usergroupID := data.UsergroupID.ValueString()
+
+	var teamIDs, linkedTeamIDs, channelIDs []string
+	if !data.TeamIDs.IsNull() {
+		diags.Append(data.TeamIDs.ElementsAs(ctx, &teamIDs, false)...)
+	}
+	if state != nil && !state.TeamIDs.IsNull() {
+		diags.Append(state.TeamIDs.ElementsAs(ctx, &linkedTeamIDs, false)...)
+	}
+	if !data.ChannelIDs.IsNull() {
+		diags.Append(data.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+	}
+	if diags.HasError() {
+		return false
+	}
+
+	// a change of auto_provision is only sent with addTeams, so every planned workspace is
+	// linked again with it
+	autoProvisionChanged := state != nil && data.AutoProvision.ValueBool() != state.AutoProvision.ValueBool()
+
+	var added []string
+	for _, teamID := range teamIDs {
+		if autoProvisionChanged || !slices.Contains(linkedTeamIDs, teamID) {
+			added = append(added, teamID)
+		}
+	}
+	for _, teamID := range linkedTeamIDs {
+		if !slices.Contains(teamIDs, teamID) {
+			diags.AddAttributeWarning(
+				path.Root("team_ids"),
+				"Workspace Stays Linked",
+				"Slack has no method to remove a user group from a workspace, so "+teamID+" stays linked to "+usergroupID+".",
+			)
+		}
+	}
+	if len(added) > 0 {
+		slices.Sort(added)
+		if err := r.client.Call(ctx, "admin.usergroups.addTeams", url.Values{
+			"usergroup_id":   {usergroupID},
+			"team_ids":       {strings.Join(added, ",")},
+			"auto_provision": {strconv.FormatBool(data.AutoProvision.ValueBool())},
+		}, nil); err != nil {
+			diags.AddError("Error linking Slack user group to workspaces", err.Error())
+			return false
+		}
+	}
+
+	var addedChannels, removedChannels []string
+	for _, channelID := range channelIDs {
+		if !slices.Contains(current, channelID) {
+			addedChannels = append(addedChannels, channelID)
+		}
+	}
+	for _, channelID := range current {
+		if !slices.Contains(channelIDs, channelID) {
+			removedChannels = append(removedChannels, channelID)
+		}
+	}
+	if len(addedChannels) > 0 {
+		slices.Sort(addedChannels)
+		if err := r.client.Call(ctx, "admin.usergroups.addChannels", url.Values{
+			"usergroup_id": {usergroupID},
+			"channel_ids":  {strings.Join(addedChannels, ",")},
+		}, nil); err != nil {
+			diags.AddError("Error adding Slack user group channels", err.Error())
+			return false
+		}
+	}
+	if len(removedChannels) > 0 {
+		slices.Sort(removedChannels)
+		if err := r.client.Call(ctx, "admin.usergroups.removeChannels", url.Values{
+			"usergroup_id": {usergroupID},
+			"channel_ids":  {strings.Join(removedChannels, ",")},
+		}, nil); err != nil {
+			diags.AddError("Error removing Slack user group channels", err.Error())
+			return false
+		}
+	}
+
+	return true
+}
+
+// listChannels returns the sorted IDs of the organization-level default channels of a usergroup.
+func (r *resourceSlackAdminUserGroupLink) listChannels(ctx context.Context, usergroupID string) ([]string, error) {
+	var result struct {
+		Channels []struct {
+			ID string `json:"id"`
+		} `json:"channels"`
+	}
+	if err := r.client.Call(ctx, "admin.usergroups.listChannels", url.Values{"usergroup_id": {usergroupID}}, &result); err != nil {
+		return nil, err
+	}
+
+	channelIDs := make([]string, 0, len(result.Channels))
+	for _, channel := range result.Channels {
+		channelIDs = append(channelIDs, channel.ID)
+	}
+	slices.Sort(channelIDs)
+	return channelIDs, nil
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func Test_resource_slack_admin_user_group_link(t *testing.T) {
+	// local stand-in keeping the linked workspaces and default channels of one usergroup in memory
+	var mu sync.Mutex
+	var teams []string
+	autoProvision := map[string]string{}
+	channels := []string{"C0OTHER"}
+
+	change := func(r *http.Request, add bool) string {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Form.Get("usergroup_id") != "S0ENGINEERS" {
+			return `{"ok": false, "error": "no_such_subteam"}`
+		}
+		for _, channelID := range strings.Split(r.Form.Get("channel_ids"), ",") {
+			if add {
+				channels = append(channels, channelID)
+			} else {
+				channels = slices.DeleteFunc(channels, func(c string) bool { return c == channelID })
+			}
+		}
+		return `{"ok": true}`
+	}
+
+	check := func(wantTeams []string, wantChannels ...string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			gotTeams := slices.Sorted(slices.Values(teams))
+			gotChannels := slices.Sorted(slices.Values(channels))
+			slices.Sort(wantChannels)
+			if !slices.Equal(gotTeams, wantTeams) {
+				return fmt.Errorf("expected teams %v, got %v", wantTeams, gotTeams)
+			}
+			if !slices.Equal(gotChannels, wantChannels) {
+				return fmt.Errorf("expected channels %v, got %v", wantChannels, gotChannels)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"usergroups.list": func(r *http.Request) string {
+				return `{"ok": true, "usergroups": [{"id": "S0ENGINEERS", "name": "Engineering", "handle": "engineering"}]}`
+			},
+			"admin.usergroups.addTeams": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				for _, teamID := range strings.Split(r.Form.Get("team_ids"), ",") {
+					teams = append(teams, teamID)
+					autoProvision[teamID] = r.Form.Get("auto_provision")
+				}
+				return `{"ok": true}`
+			},
+			"admin.usergroups.addChannels": func(r *http.Request) string {
+				return change(r, true)
+			},
+			"admin.usergroups.removeChannels": func(r *http.Request) string {
+				return change(r, false)
+			},
+			"admin.usergroups.listChannels": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if r.Form.Get("usergroup_id") != "S0ENGINEERS" {
+					return `{"ok": false, "error": "no_such_subteam"}`
+				}
+				list := []map[string]string{}
+				for _, channelID := range channels {
+					list = append(list, map[string]string{"id": channelID})
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "channels": list})
+				return string(body)
+			},
+		},
+		checkDestroy: func(_ *terraform.State) error {
+			// workspaces stay linked, as Slack cannot remove them
+			return check([]string{"T0ONE", "T0ONE", "T0TWO", "T0TWO"})(nil)
+		},
+		steps: []resource.TestStep{
+			{
+				// the channel added outside Terraform is removed
+				Config: testSlackAdminUserGroupLinkConfig(true, `"T0ONE"`, `"C0GENERAL"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_user_group_link.test", "id", "S0ENGINEERS"),
+					resource.TestCheckResourceAttr("slack_admin_user_group_link.test", "usergroup_id", "S0ENGINEERS"),
+					resource.TestCheckResourceAttr("slack_admin_user_group_link.test", "channel_ids.#", "1"),
+					check([]string{"T0ONE"}, "C0GENERAL"),
+				),
+			},
+			{
+				Config: testSlackAdminUserGroupLinkConfig(true, `"T0ONE", "T0TWO"`, `"C0RANDOM", "C0DEV"`),
+				Check:  check([]string{"T0ONE", "T0TWO"}, "C0DEV", "C0RANDOM"),
+			},
+			{
+				// a channel removed outside Terraform is added again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					channels = []string{"C0DEV"}
+				},
+				Config: testSlackAdminUserGroupLinkConfig(true, `"T0ONE", "T0TWO"`, `"C0RANDOM", "C0DEV"`),
+				Check:  check([]string{"T0ONE", "T0TWO"}, "C0DEV", "C0RANDOM"),
+			},
+			{
+				ResourceName:            "slack_admin_user_group_link.test",
+				ImportState:             true,
+				ImportStateId:           "S0ENGINEERS",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_provision", "team_ids", "usergroup"},
+			},
+			{
+				// auto_provision is only sent when linking, so every workspace is linked again
+				Config: testSlackAdminUserGroupLinkConfig(false, `"T0ONE", "T0TWO"`, `"C0RANDOM", "C0DEV"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_admin_user_group_link.test", "auto_provision", "false"),
+					check([]string{"T0ONE", "T0ONE", "T0TWO", "T0TWO"}, "C0DEV", "C0RANDOM"),
+					testCheckStandIn(&mu, func() error {
+						if autoProvision["T0ONE"] != "false" || autoProvision["T0TWO"] != "false" {
+							return fmt.Errorf("expected auto_provision false for every workspace, got %v", autoProvision)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testSlackAdminUserGroupLinkConfig(autoProvision bool, teamIDs string, channelIDs string) string {
+	return fmt.Sprintf(`
+        resource "slack_admin_user_group_link" "test" {
+            usergroup      = "@engineering"
+            team_ids       = [%s]
+            auto_provision = %t
+            channel_ids    = [%s]
+        }
+    `, teamIDs, autoProvision, channelIDs)
+}
@@ -0,0 +1,476 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	"terraform-provider-slack/internal/slackutil"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackAppManifest)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackAppManifest)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceSlackAppManifest)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackAppManifest)(nil)
+)
+
+type AppManifest struct {
+	AppID             types.String     `tfsdk:"app_id"`
+	ClientID          types.String     `tfsdk:"client_id"`
+	ClientSecret      types.String     `tfsdk:"client_secret"`
+	ID                types.String     `tfsdk:"id"`
+	Manifest          appManifestValue `tfsdk:"manifest"`
+	OAuthAuthorizeURL types.String     `tfsdk:"oauth_authorize_url"`
+	SigningSecret     types.String     `tfsdk:"signing_secret"`
+	VerificationToken types.String     `tfsdk:"verification_token"`
+}
+
+type resourceSlackAppManifest struct {
+	client *slackClient
+}
+
+func NewResourceSlackAppManifest() resource.Resource {
+	return &resourceSlackAppManifest{}
+}
+
+func (r *resourceSlackAppManifest) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackAppManifest) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_app_manifest"
+}
+
+func (r *resourceSlackAppManifest) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var manifest appManifestValue
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manifest"), &manifest)...)
+	if resp.Diagnostics.HasError() || manifest.IsNull() || manifest.IsUnknown() {
+		return
+	}
+
+	if _, err := appManifestJSON(manifest.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid App Manifest", err.Error())
+	}
+}
+
+// ModifyPlan validates a changed manifest with apps.manifest.validate.
+func (r *resourceSlackAppManifest) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, current appManifestValue
+	var appID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manifest"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("manifest"), &current)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("app_id"), &appID)...)
+	}
+	if resp.Diagnostics.HasError() || planned.IsUnknown() {
+		return
+	}
+
+	manifest, err := appManifestJSON(planned.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid App Manifest", err.Error())
+		return
+	}
+
+	if !current.IsNull() {
+		if currentManifest, err := appManifestJSON(current.ValueString()); err == nil && currentManifest == manifest {
+			return
+		}
+	}
+
+	// the provider is not configured yet when its own configuration is unknown
+	if r.client == nil {
+		return
+	}
+
+	values := url.Values{"manifest": {manifest}}
+	if !appID.IsNull() {
+		values.Set("app_id", appID.ValueString())
+	}
+
+	var result struct {
+		Errors []struct {
+			Message string `json:"message"`
+			Pointer string `json:"pointer"`
+		} `json:"errors"`
+	}
+	if err := r.client.CallAppConfiguration(ctx, "apps.manifest.validate", values, &result); err != nil {
+		if len(result.Errors) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Error Validating App Manifest", err.Error())
+			return
+		}
+		for _, manifestError := range result.Errors {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("manifest"),
+				"Invalid App Manifest",
+				fmt.Sprintf("%s: %s", defaultIfEmpty(manifestError.Pointer, "/"), manifestError.Message),
+			)
+		}
+	}
+}
+
+func (r *resourceSlackAppManifest) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_app_manifest", &resp.Diagnostics) {
+		return
+	}
+
+	var data AppManifest
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := appManifestJSON(data.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid App Manifest", err.Error())
+		return
+	}
+
+	var result struct {
+		AppID       string `json:"app_id"`
+		Credentials struct {
+			ClientID          string `json:"client_id"`
+			ClientSecret      string `json:"client_secret"`
+			SigningSecret     string `json:"signing_secret"`
+			VerificationToken string `json:"verification_token"`
+		} `json:"credentials"`
+		OAuthAuthorizeURL string `json:"oauth_authorize_url"`
+	}
+	if err := r.client.CallAppConfiguration(ctx, "apps.manifest.create", url.Values{"manifest": {manifest}}, &result); err != nil {
+		resp.Diagnostics.AddError("Error creating Slack app", err.Error())
+		return
+	}
+
+	data.AppID = types.StringValue(result.AppID)
+	data.ID = types.StringValue(result.AppID)
+	data.ClientID = types.StringValue(result.Credentials.ClientID)
+	data.ClientSecret = types.StringValue(result.Credentials.ClientSecret)
+	data.SigningSecret = types.StringValue(result.Credentials.SigningSecret)
+	data.VerificationToken = types.StringValue(result.Credentials.VerificationToken)
+	data.OAuthAuthorizeURL = types.StringValue(result.OAuthAuthorizeURL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Created Slack app", map[string]interface{}{
+		"app_id": result.AppID,
+	})
+}
+
+func (r *resourceSlackAppManifest) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_app_manifest", &resp.Diagnostics) {
+		return
+	}
+
+	var data AppManifest
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CallAppConfiguration(ctx, "apps.manifest.delete", url.Values{"app_id": {data.AppID.ValueString()}}, nil); err != nil {
+		if isSlackError(err, "app_not_found", "invalid_app_id") {
+			tflog.Warn(ctx, "Slack app not found, assuming it was already deleted", map[string]interface{}{
+				"app_id": data.AppID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Slack app", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Slack app", map[string]interface{}{
+		"app_id": data.AppID.ValueString(),
+	})
+}
+
+func (r *resourceSlackAppManifest) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), req.ID)...)
+}
+
+func (r *resourceSlackAppManifest) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppManifest
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Manifest json.RawMessage `json:"manifest"`
+	}
+	err := r.client.CallAppConfiguration(ctx, "apps.manifest.export", url.Values{"app_id": {data.AppID.ValueString()}}, &result)
+	if err != nil {
+		if isSlackError(err, "app_not_found", "invalid_app_id") {
+			tflog.Warn(ctx, "Slack app not found, removing it from state", map[string]interface{}{
+				"app_id": data.AppID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error exporting Slack app manifest", err.Error())
+		return
+	}
+
+	exported, err := appManifestJSON(string(result.Manifest))
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding Slack app manifest", err.Error())
+		return
+	}
+
+	// the manifest in the state is kept when the exported one is semantically equal to it
+	data.Manifest = appManifestValue{StringValue: types.StringValue(exported)}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackAppManifest) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_app_manifest** resource manages a Slack app from its manifest.
+
+The manifest may be written in YAML or JSON. It is validated with Slack during plan, and changes that only affect formatting or key order are stored without updating the app. The apps.manifest methods are called with the provider ` + "`app_configuration_token`" + `.
+
+The credentials of the app are only returned when it is created, so they are empty after an import.
+
+Import is supported using the app ID.
+
+**Required scopes**
+
+App configuration tokens: no scopes are required.
+`,
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The OAuth client ID of the app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The OAuth client secret of the app.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				MarkdownDescription: "The app manifest, in YAML or JSON.",
+				CustomType:          appManifestType{},
+				Required:            true,
+			},
+			"oauth_authorize_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to install the app in a workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_secret": schema.StringAttribute{
+				MarkdownDescription: "The secret used to verify the requests Slack sends to the app.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_token": schema.StringAttribute{
+				MarkdownDescription: "The deprecated verification token of the app.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackAppManifest) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_app_manifest", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state AppManifest
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := appManifestJSON(data.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid App Manifest", err.Error())
+		return
+	}
+
+	// a manifest that only changed in format or key order is stored without updating the app
+	if current, err := appManifestJSON(state.Manifest.ValueString()); err == nil && current == manifest {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if err := r.client.CallAppConfiguration(ctx, "apps.manifest.update", url.Values{
+		"app_id":   {data.AppID.ValueString()},
+		"manifest": {manifest},
+	}, nil); err != nil {
+		resp.Diagnostics.AddError("Error updating Slack app", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack app", map[string]interface{}{
+		"app_id": data.AppID.ValueString(),
+	})
+}
+
+// appManifestJSON returns a YAML or JSON manifest as JSON with sorted keys, so that
+// manifests differing only in format or key order are equal.
+func appManifestJSON(manifest string) (string, error) {
+	var value any
+	if err := yaml.Unmarshal([]byte(manifest), &value); err != nil {
+		return "", fmt.Errorf("the manifest is neither valid YAML nor JSON: %w", err)
+	}
+	if _, ok := value.(map[string]any); !ok {
+		return "", fmt.Errorf("the manifest must be an object")
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("the manifest cannot be encoded as JSON: %w", err)
+	}
+	return string(encoded), nil
+}
+
+var (
+	_ basetypes.StringTypable                    = appManifestType{}
+	_ basetypes.StringValuableWithSemanticEquals = appManifestValue{}
+)
+
+// appManifestType is the type of the manifest attribute, whose values are semantically equal
+// when they only differ in format or key order.
+type appManifestType struct {
+	basetypes.StringType
+}
+
+func (t appManifestType) Equal(o attr.Type) bool {
+	other, ok := o.(appManifestType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t appManifestType) String() string {
+	return "appManifestType"
+}
+
+func (t appManifestType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return appManifestValue{StringValue: in}, nil
+}
+
+func (t appManifestType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return appManifestValue{StringValue: stringValue}, nil
+}
+
+func (t appManifestType) ValueType(_ context.Context) attr.Value {
+	return appManifestValue{}
+}
+
+type appManifestValue struct {
+	basetypes.StringValue
+}
+
+func (v appManifestValue) Equal(o attr.Value) bool {
+	other, ok := o.(appManifestValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v appManifestValue) Type(_ context.Context) attr.Type {
+	return appManifestType{}
+}
+
+// StringSemanticEquals reports whether the prior manifest is contained in this one, as the
+// manifest Slack exports has defaults such as empty settings added to the one it was given.
+func (v appManifestValue) StringSemanticEquals(_ context.Context, priorValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	prior, ok := priorValuable.(appManifestValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T but got %T.", v, priorValuable))
+		return false, diags
+	}
+
+	manifest, err := appManifestJSON(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	priorManifest, err := appManifestJSON(prior.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	equal, err := slackutil.IsJSONSubset(priorManifest, manifest)
+	if err != nil {
+		diags.AddError("Error comparing Slack app manifest", err.Error())
+	}
+	return equal, diags
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_app_manifest(t *testing.T) {
+	// local stand-in keeping the manifests of the apps in memory, with the defaults Slack adds
+	var mu sync.Mutex
+	apps := map[string]map[string]any{}
+	updates := 0
+
+	decode := func(r *http.Request) (map[string]any, string) {
+		if r.Header.Get("Authorization") != "Bearer xoxe.xoxp-config" {
+			return nil, `{"ok": false, "error": "invalid_auth"}`
+		}
+		var manifest map[string]any
+		if err := json.Unmarshal([]byte(r.Form.Get("manifest")), &manifest); err != nil {
+			return nil, `{"ok": false, "error": "invalid_manifest"}`
+		}
+		if _, ok := manifest["display_information"]; !ok {
+			return nil, `{"ok": false, "error": "invalid_manifest", "errors": [{"message": "must have required property 'display_information'", "pointer": "/"}]}`
+		}
+		manifest["settings"] = map[string]any{"org_deploy_enabled": false}
+		return manifest, ""
+	}
+
+	checkUpdates := func(want int) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if updates != want {
+				return fmt.Errorf("expected %d app updates, got %d", want, updates)
+			}
+			return nil
+		})
+	}
+
+	checkName := func(want string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			got := apps["A0APP"]["display_information"].(map[string]any)["name"]
+			if got != want {
+				return fmt.Errorf("expected app name %q, got %q", want, got)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		providerConfig: `app_configuration_token = "xoxe.xoxp-config"`,
+		handlers: map[string]func(r *http.Request) string{
+			"apps.manifest.validate": func(r *http.Request) string {
+				if _, errorBody := decode(r); errorBody != "" {
+					return errorBody
+				}
+				return `{"ok": true}`
+			},
+			"apps.manifest.create": func(r *http.Request) string {
+				manifest, errorBody := decode(r)
+				if errorBody != "" {
+					return errorBody
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				apps["A0APP"] = manifest
+				return `{"ok": true, "app_id": "A0APP", "credentials": {"client_id": "1.2", "client_secret": "secret", "verification_token": "token", "signing_secret": "signing"}, "oauth_authorize_url": "https://slack.com/oauth/v2/authorize?client_id=1.2"}`
+			},
+			"apps.manifest.update": func(r *http.Request) string {
+				manifest, errorBody := decode(r)
+				if errorBody != "" {
+					return errorBody
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				apps[r.Form.Get("app_id")] = manifest
+				updates++
+				return `{"ok": true, "app_id": "A0APP", "permissions_updated": false}`
+			},
+			"apps.manifest.export": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				manifest, ok := apps[r.Form.Get("app_id")]
+				if !ok {
+					return `{"ok": false, "error": "app_not_found"}`
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "manifest": manifest})
+				return string(body)
+			},
+			"apps.manifest.delete": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				delete(apps, r.Form.Get("app_id"))
+				return `{"ok": true}`
+			},
+		},
+		checkDestroy: testCheckStandIn(&mu, func() error {
+			if len(apps) > 0 {
+				return fmt.Errorf("expected no apps, got %v", apps)
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config: testSlackAppManifestConfig(`
+                    features:
+                      bot_user:
+                        display_name: Poll
+                `),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must have required property 'display_information'"),
+			},
+			{
+				Config: testSlackAppManifestConfig(`
+                    display_information:
+                      name: Poll
+                    features:
+                      bot_user:
+                        display_name: Poll
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_app_manifest.test", "app_id", "A0APP"),
+					resource.TestCheckResourceAttr("slack_app_manifest.test", "client_secret", "secret"),
+					resource.TestCheckResourceAttr("slack_app_manifest.test", "oauth_authorize_url", "https://slack.com/oauth/v2/authorize?client_id=1.2"),
+					checkName("Poll"),
+				),
+			},
+			{
+				// the same manifest as JSON with another key order does not update the app
+				Config: testSlackAppManifestConfig(`{"features": {"bot_user": {"display_name": "Poll"}}, "display_information": {"name": "Poll"}}`),
+				Check:  checkUpdates(0),
+			},
+			{
+				Config: testSlackAppManifestConfig(`
+                    display_information:
+                      name: Polls
+                    features:
+                      bot_user:
+                        display_name: Poll
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_app_manifest.test", "client_secret", "secret"),
+					checkName("Polls"),
+					checkUpdates(1),
+				),
+			},
+			{
+				ResourceName:            "slack_app_manifest.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest", "client_id", "client_secret", "oauth_authorize_url", "signing_secret", "verification_token"},
+			},
+		},
+	})
+}
+
+func testSlackAppManifestConfig(manifest string) string {
+	return fmt.Sprintf(`
+        resource "slack_app_manifest" "test" {
+            manifest = <<-EOT
+%s
+            EOT
+        }
+    `, manifest)
+}
@@ -0,0 +1,428 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackCanvas)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackCanvas)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackCanvas)(nil)
+)
+
+// canvasAccessLevels are the access levels canvases.access.set accepts.
+var canvasAccessLevels = []string{"read", "write"}
+
+type Canvas struct {
+	Channel       types.String `tfsdk:"channel"`
+	ChannelAccess types.Map    `tfsdk:"channel_access"`
+	ChannelID     types.String `tfsdk:"channel_id"`
+	Content       types.String `tfsdk:"content"`
+	ID            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	UserAccess    types.Map    `tfsdk:"user_access"`
+}
+
+type resourceSlackCanvas struct {
+	client *slackClient
+}
+
+func NewResourceSlackCanvas() resource.Resource {
+	return &resourceSlackCanvas{}
+}
+
+func (r *resourceSlackCanvas) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackCanvas) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_canvas"
+}
+
+func (r *resourceSlackCanvas) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data Canvas
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attribute := range []struct {
+		name   string
+		access types.Map
+	}{
+		{"channel_access", data.ChannelAccess},
+		{"user_access", data.UserAccess},
+	} {
+		if attribute.access.IsNull() || attribute.access.IsUnknown() {
+			continue
+		}
+
+		var access map[string]types.String
+		resp.Diagnostics.Append(attribute.access.ElementsAs(ctx, &access, false)...)
+		for key, level := range access {
+			if level.IsUnknown() {
+				continue
+			}
+			if !slices.Contains(canvasAccessLevels, level.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute.name).AtMapKey(key),
+					"Invalid Canvas Access Level",
+					fmt.Sprintf("The access level must be one of %v, got %q.", canvasAccessLevels, level.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *resourceSlackCanvas) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_canvas", &resp.Diagnostics) {
+		return
+	}
+
+	var data Canvas
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := url.Values{}
+	if !data.Title.IsNull() {
+		values.Set("title", data.Title.ValueString())
+	}
+	if !data.Content.IsNull() {
+		values.Set("document_content", canvasDocumentContent(data.Content.ValueString()))
+	}
+
+	// a channel canvas is created with conversations.canvases.create, a standalone canvas with canvases.create
+	method := "canvases.create"
+	data.ChannelID = types.StringNull()
+	if !data.Channel.IsNull() {
+		channelID, err := r.client.ResolveConversationID(data.Channel.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Channel Retrieval Error on Create",
+				fmt.Sprintf("Failed to retrieve conversation ID: %v", err),
+			)
+			return
+		}
+		method = "conversations.canvases.create"
+		values.Set("channel_id", channelID)
+		data.ChannelID = types.StringValue(channelID)
+	}
+
+	var created struct {
+		CanvasID string `json:"canvas_id"`
+	}
+	if err := r.client.Call(ctx, method, values, &created); err != nil {
+		resp.Diagnostics.AddError("Error creating Slack canvas", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(created.CanvasID)
+
+	if err := r.syncAccess(ctx, created.CanvasID, types.MapNull(types.StringType), data.ChannelAccess, types.MapNull(types.StringType), data.UserAccess); err != nil {
+		resp.Diagnostics.AddError("Error setting Slack canvas access", err.Error())
+		// save the canvas so it is not orphaned, without the access that was not set
+		data.ChannelAccess = types.MapNull(types.StringType)
+		data.UserAccess = types.MapNull(types.StringType)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Created Slack canvas", map[string]interface{}{
+		"id":     created.CanvasID,
+		"method": method,
+	})
+}
+
+func (r *resourceSlackCanvas) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_canvas", &resp.Diagnostics) {
+		return
+	}
+
+	var data Canvas
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Call(ctx, "canvases.delete", url.Values{"canvas_id": {data.ID.ValueString()}}, nil)
+	if err != nil {
+		if isSlackError(err, "canvas_not_found", "canvas_deleted") {
+			tflog.Warn(ctx, "Slack canvas not found, assuming it was already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Slack canvas", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Slack canvas", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackCanvas) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceSlackCanvas) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data Canvas
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// canvases are files, and files.info is the only way to look one up
+	file, _, _, err := r.client.GetFileInfoContext(ctx, data.ID.ValueString(), 0, 0)
+	if err != nil {
+		if isSlackError(err, "file_not_found", "file_deleted", "canvas_not_found", "canvas_deleted") {
+			tflog.Warn(ctx, "Slack canvas not found, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack canvas", err.Error())
+		return
+	}
+
+	// Slack does not return the markdown content, so only the title is checked for drift
+	if !data.Title.IsNull() {
+		data.Title = types.StringValue(file.Title)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack canvas", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackCanvas) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_canvas** resource manages a Slack canvas, either standalone or as the canvas of a channel.
+
+Changes to the title or content are applied as edits that replace the whole canvas. Slack does not return the markdown content of a canvas, so edits made in Slack are not detected and are overwritten by the next content change. Access is managed for the users and channels listed in ` + "`user_access`" + ` and ` + "`channel_access`" + `. Access granted outside Terraform is left alone.
+
+Import is supported using the canvas ID. Imported canvases have no content until it is set in the configuration.
+
+**Required scopes**
+
+Bot tokens: canvases:read, canvases:write, files:read, channels:read, groups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the channel to create the canvas in. A channel can only have one channel canvas.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_access": schema.MapAttribute{
+				MarkdownDescription: "The access level (`read` or `write`) of channels to the canvas, keyed by channel ID.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel the canvas belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The content of the canvas, in markdown.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the canvas.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the canvas.",
+				Optional:            true,
+			},
+			"user_access": schema.MapAttribute{
+				MarkdownDescription: "The access level (`read` or `write`) of users to the canvas, keyed by user ID.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackCanvas) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_canvas", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state Canvas
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	canvasID := state.ID.ValueString()
+
+	var changes []map[string]any
+	if !data.Title.Equal(state.Title) && !data.Title.IsNull() {
+		changes = append(changes, map[string]any{
+			"operation":     "rename",
+			"title_content": map[string]string{"type": "markdown", "markdown": data.Title.ValueString()},
+		})
+	}
+	if !data.Content.Equal(state.Content) {
+		changes = append(changes, map[string]any{
+			"operation":        "replace",
+			"document_content": map[string]string{"type": "markdown", "markdown": data.Content.ValueString()},
+		})
+	}
+
+	if len(changes) > 0 {
+		encoded, err := json.Marshal(changes)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding Slack canvas changes", err.Error())
+			return
+		}
+
+		err = r.client.Call(ctx, "canvases.edit", url.Values{"canvas_id": {canvasID}, "changes": {string(encoded)}}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error editing Slack canvas", err.Error())
+			return
+		}
+	}
+
+	if err := r.syncAccess(ctx, canvasID, state.ChannelAccess, data.ChannelAccess, state.UserAccess, data.UserAccess); err != nil {
+		resp.Diagnostics.AddError("Error setting Slack canvas access", err.Error())
+		return
+	}
+
+	data.ChannelID = state.ChannelID
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack canvas", map[string]interface{}{
+		"id": canvasID,
+	})
+}
+
+// syncAccess grants the planned access and revokes the access that is no longer configured.
+func (r *resourceSlackCanvas) syncAccess(ctx context.Context, canvasID string, stateChannels types.Map, planChannels types.Map, stateUsers types.Map, planUsers types.Map) error {
+	var diags diag.Diagnostics
+	currentChannels := canvasAccessMap(ctx, stateChannels, &diags)
+	plannedChannels := canvasAccessMap(ctx, planChannels, &diags)
+	currentUsers := canvasAccessMap(ctx, stateUsers, &diags)
+	plannedUsers := canvasAccessMap(ctx, planUsers, &diags)
+	if diags.HasError() {
+		return fmt.Errorf("failed to read canvas access: %v", diags.Errors())
+	}
+
+	// canvases.access.delete and canvases.access.set take either channel_ids or user_ids, so
+	// channel and user access is changed by separate calls
+	if err := r.syncAccessOf(ctx, canvasID, "channel_ids", currentChannels, plannedChannels); err != nil {
+		return err
+	}
+	return r.syncAccessOf(ctx, canvasID, "user_ids", currentUsers, plannedUsers)
+}
+
+// syncAccessOf grants the planned access and revokes the access that is no longer configured
+// for the channels or users given by key, sending the removals together and grouping the
+// changes by level.
+func (r *resourceSlackCanvas) syncAccessOf(ctx context.Context, canvasID string, key string, current map[string]string, planned map[string]string) error {
+	var removed []string
+	for id := range current {
+		if _, ok := planned[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	if len(removed) > 0 {
+		values := url.Values{"canvas_id": {canvasID}}
+		setJSONList(values, key, removed)
+		if err := r.client.Call(ctx, "canvases.access.delete", values, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, level := range canvasAccessLevels {
+		var ids []string
+		for id, l := range planned {
+			if l == level && current[id] != level {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		values := url.Values{"canvas_id": {canvasID}, "access_level": {level}}
+		setJSONList(values, key, ids)
+		if err := r.client.Call(ctx, "canvases.access.set", values, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// canvasDocumentContent returns the document_content parameter for markdown content.
+func canvasDocumentContent(markdown string) string {
+	encoded, _ := json.Marshal(map[string]string{"type": "markdown", "markdown": markdown})
+	return string(encoded)
+}
+
+// canvasAccessMap returns the access levels keyed by user or channel ID.
+func canvasAccessMap(ctx context.Context, access types.Map, diags *diag.Diagnostics) map[string]string {
+	result := map[string]string{}
+	if access.IsNull() || access.IsUnknown() {
+		return result
+	}
+	diags.Append(access.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
+// setJSONList sets a parameter to a sorted JSON array of IDs when there are any.
+func setJSONList(values url.Values, key string, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+	sort.Strings(ids)
+	encoded, _ := json.Marshal(ids)
+	values.Set(key, string(encoded))
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_canvas(t *testing.T) {
+	// local stand-in keeping the canvas and its access in memory
+	var mu sync.Mutex
+	title := ""
+	content := ""
+	access := map[string]string{}
+
+	checkCanvas := func(wantContent string, wantAccess map[string]string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if content != wantContent {
+				return fmt.Errorf("expected canvas content %q, got %q", wantContent, content)
+			}
+			if fmt.Sprint(access) != fmt.Sprint(wantAccess) {
+				return fmt.Errorf("expected canvas access %v, got %v", wantAccess, access)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0PLATFORM", "name": "platform", "is_channel": true}], "response_metadata": {"next_cursor": ""}}`
+			},
+			"conversations.canvases.create": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				var document struct {
+					Markdown string `json:"markdown"`
+				}
+				_ = json.Unmarshal([]byte(r.Form.Get("document_content")), &document)
+				title = r.Form.Get("title")
+				content = document.Markdown
+				return `{"ok": true, "canvas_id": "F0CANVAS"}`
+			},
+			"canvases.edit": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				var changes []struct {
+					Operation       string            `json:"operation"`
+					DocumentContent map[string]string `json:"document_content"`
+				}
+				_ = json.Unmarshal([]byte(r.Form.Get("changes")), &changes)
+				for _, change := range changes {
+					if change.Operation == "replace" {
+						content = change.DocumentContent["markdown"]
+					}
+				}
+				return `{"ok": true}`
+			},
+			"canvases.access.set": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				ids, ok := canvasStandInAccessIDs(r)
+				if !ok {
+					return `{"ok": false, "error": "invalid_arguments"}`
+				}
+				for _, id := range ids {
+					access[id] = r.Form.Get("access_level")
+				}
+				return `{"ok": true}`
+			},
+			"canvases.access.delete": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				ids, ok := canvasStandInAccessIDs(r)
+				if !ok {
+					return `{"ok": false, "error": "invalid_arguments"}`
+				}
+				for _, id := range ids {
+					delete(access, id)
+				}
+				return `{"ok": true}`
+			},
+			"canvases.delete": func(r *http.Request) string {
+				return `{"ok": true}`
+			},
+			"files.info": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				body, _ := json.Marshal(map[string]any{"ok": true, "file": map[string]any{"id": "F0CANVAS", "title": title, "filetype": "quip"}})
+				return string(body)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: testSlackCanvasConfig("# Platform", `{ C0PLATFORM = "read" }`, `{ U0ALICE = "write", U0BOB = "read" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_canvas.test", "id", "F0CANVAS"),
+					resource.TestCheckResourceAttr("slack_canvas.test", "channel_id", "C0PLATFORM"),
+					checkCanvas("# Platform", map[string]string{"C0PLATFORM": "read", "U0ALICE": "write", "U0BOB": "read"}),
+				),
+			},
+			{
+				Config: testSlackCanvasConfig("# Platform team", `{ C0PLATFORM = "write" }`, `{ U0ALICE = "read" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_canvas.test", "content", "# Platform team"),
+					checkCanvas("# Platform team", map[string]string{"C0PLATFORM": "write", "U0ALICE": "read"}),
+				),
+			},
+		},
+	})
+}
+
+// canvasStandInAccessIDs returns the channel or user IDs of a canvases.access call, which
+// takes only one of them.
+func canvasStandInAccessIDs(r *http.Request) ([]string, bool) {
+	var channels, users []string
+	_ = json.Unmarshal([]byte(r.Form.Get("channel_ids")), &channels)
+	_ = json.Unmarshal([]byte(r.Form.Get("user_ids")), &users)
+	if len(channels) > 0 && len(users) > 0 {
+		return nil, false
+	}
+	return append(channels, users...), true
+}
+
+func testSlackCanvasConfig(content string, channelAccess string, userAccess string) string {
+	return fmt.Sprintf(`
+        resource "slack_canvas" "test" {
+            channel        = "platform"
+            title          = "Platform wiki"
+            content        = "%s"
+            channel_access = %s
+            user_access    = %s
+        }
+    `, content, channelAccess, userAccess)
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                = (*resourceSlackConversationBookmark)(nil)
+	_ resource.ResourceWithImportState = (*resourceSlackConversationBookmark)(nil)
+)
+
+type ConversationBookmark struct {
+	Channel   types.String `tfsdk:"channel"`
+	ChannelID types.String `tfsdk:"channel_id"`
+	Emoji     types.String `tfsdk:"emoji"`
+	ID        types.String `tfsdk:"id"`
+	Link      types.String `tfsdk:"link"`
+	Title     types.String `tfsdk:"title"`
+	Type      types.String `tfsdk:"type"`
+}
+
+type resourceSlackConversationBookmark struct {
+	client *slackClient
+}
+
+func NewResourceSlackConversationBookmark() resource.Resource {
+	return &resourceSlackConversationBookmark{}
+}
+
+func (r *resourceSlackConversationBookmark) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackConversationBookmark) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_conversation_bookmark"
+}
+
+func (r *resourceSlackConversationBookmark) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_conversation_bookmark", &resp.Diagnostics) {
+		return
+	}
+
+	var data ConversationBookmark
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID, err := r.client.ResolveConversationID(data.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Channel Retrieval Error on Create",
+			fmt.Sprintf("Failed to retrieve conversation ID: %v", err),
+		)
+		return
+	}
+
+	bookmark, err := r.client.AddBookmarkContext(ctx, channelID, slack.AddBookmarkParameters{
+		Title: data.Title.ValueString(),
+		Type:  data.Type.ValueString(),
+		Link:  data.Link.ValueString(),
+		Emoji: data.Emoji.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error adding Slack bookmark", err.Error())
+		return
+	}
+
+	data.ChannelID = types.StringValue(channelID)
+	data.ID = types.StringValue(bookmark.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Added Slack bookmark", map[string]interface{}{
+		"channel_id": channelID,
+		"id":         bookmark.ID,
+	})
+}
+
+func (r *resourceSlackConversationBookmark) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_conversation_bookmark", &resp.Diagnostics) {
+		return
+	}
+
+	var data ConversationBookmark
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveBookmarkContext(ctx, data.ChannelID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if isSlackError(err, "not_found", "channel_not_found") {
+			tflog.Warn(ctx, "Slack bookmark not found, assuming it was already removed", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error removing Slack bookmark", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Removed Slack bookmark", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackConversationBookmark) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	channelID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || channelID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form `channel_id/bookmark_id`, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel"), channelID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+}
+
+func (r *resourceSlackConversationBookmark) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationBookmark
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bookmarks, err := r.client.ListBookmarksContext(ctx, data.ChannelID.ValueString())
+	if err != nil && !isSlackError(err, "channel_not_found") {
+		resp.Diagnostics.AddError("Error retrieving Slack bookmarks", err.Error())
+		return
+	}
+
+	var bookmark *slack.Bookmark
+	for _, b := range bookmarks {
+		if b.ID == data.ID.ValueString() {
+			bCopy := b
+			bookmark = &bCopy
+			break
+		}
+	}
+
+	if bookmark == nil {
+		tflog.Warn(ctx, "Slack bookmark not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Title = types.StringValue(bookmark.Title)
+	data.Link = types.StringValue(bookmark.Link)
+	data.Type = types.StringValue(bookmark.Type)
+	if bookmark.Emoji != "" || !data.Emoji.IsNull() {
+		data.Emoji = types.StringValue(bookmark.Emoji)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack bookmark", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackConversationBookmark) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_conversation_bookmark** resource manages a bookmark in a Slack channel, such as a link to a runbook, dashboard or on-call schedule.
+
+Changes to the title, link or emoji are made in place. Bookmarks removed outside Terraform are added again.
+
+Import is supported using ` + "`channel_id/bookmark_id`" + `.
+
+**Required scopes**
+
+Bot tokens: bookmarks:read, bookmarks:write, channels:read, groups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the channel to add the bookmark to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessResolvesTo(&r.client, path.Root("channel_id"), (*slackClient).ResolveConversationID),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel the bookmark is in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emoji": schema.StringAttribute{
+				MarkdownDescription: "The emoji shown next to the bookmark, e.g. `:books:`.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bookmark.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"link": schema.StringAttribute{
+				MarkdownDescription: "The URL the bookmark links to.",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the bookmark.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the bookmark. Defaults to `link`, the only type Slack currently supports.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("link"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackConversationBookmark) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_conversation_bookmark", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state ConversationBookmark
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	title := data.Title.ValueString()
+	// an empty emoji clears it
+	emoji := data.Emoji.ValueString()
+
+	_, err := r.client.EditBookmarkContext(ctx, state.ChannelID.ValueString(), state.ID.ValueString(), slack.EditBookmarkParameters{
+		Title: &title,
+		Emoji: &emoji,
+		Link:  data.Link.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error editing Slack bookmark", err.Error())
+		return
+	}
+
+	data.ChannelID = state.ChannelID
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Edited Slack bookmark", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_conversation_bookmark(t *testing.T) {
+	// local stand-in keeping the channel bookmarks in memory
+	var mu sync.Mutex
+	bookmarks := map[string]map[string]any{}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0PLATFORM", "name": "platform", "is_channel": true}], "response_metadata": {"next_cursor": ""}}`
+			},
+			"bookmarks.add": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				bookmark := map[string]any{"id": "Bk0RUNBOOK", "channel_id": r.Form.Get("channel_id"), "title": r.Form.Get("title"), "link": r.Form.Get("link"), "emoji": r.Form.Get("emoji"), "type": r.Form.Get("type")}
+				bookmarks["Bk0RUNBOOK"] = bookmark
+				body, _ := json.Marshal(map[string]any{"ok": true, "bookmark": bookmark})
+				return string(body)
+			},
+			"bookmarks.edit": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				bookmark := bookmarks[r.Form.Get("bookmark_id")]
+				bookmark["title"] = r.Form.Get("title")
+				bookmark["link"] = r.Form.Get("link")
+				bookmark["emoji"] = r.Form.Get("emoji")
+				body, _ := json.Marshal(map[string]any{"ok": true, "bookmark": bookmark})
+				return string(body)
+			},
+			"bookmarks.remove": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				delete(bookmarks, r.Form.Get("bookmark_id"))
+				return `{"ok": true}`
+			},
+			"bookmarks.list": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				list := []any{}
+				for _, bookmark := range bookmarks {
+					list = append(list, bookmark)
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "bookmarks": list})
+				return string(body)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: testSlackConversationBookmarkConfig("Runbook", `emoji = ":books:"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_conversation_bookmark.test", "channel_id", "C0PLATFORM"),
+					resource.TestCheckResourceAttr("slack_conversation_bookmark.test", "id", "Bk0RUNBOOK"),
+					resource.TestCheckResourceAttr("slack_conversation_bookmark.test", "type", "link"),
+				),
+			},
+			{
+				Config: testSlackConversationBookmarkConfig("Platform runbook", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_conversation_bookmark.test", "title", "Platform runbook"),
+					resource.TestCheckNoResourceAttr("slack_conversation_bookmark.test", "emoji"),
+				),
+			},
+			{
+				ResourceName:            "slack_conversation_bookmark.test",
+				ImportState:             true,
+				ImportStateIdPrefix:     "C0PLATFORM/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"channel"},
+			},
+		},
+	})
+}
+
+func testSlackConversationBookmarkConfig(title string, extra string) string {
+	return fmt.Sprintf(`
+        resource "slack_conversation_bookmark" "test" {
+            channel = "#platform"
+            title   = "%s"
+            link    = "https://example.com/runbook"
+            %s
+        }
+    `, title, extra)
+}
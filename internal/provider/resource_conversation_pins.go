@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                = (*resourceSlackConversationPins)(nil)
+	_ resource.ResourceWithImportState = (*resourceSlackConversationPins)(nil)
+)
+
+type ConversationPins struct {
+	Authoritative     types.Bool   `tfsdk:"authoritative"`
+	Channel           types.String `tfsdk:"channel"`
+	ChannelID         types.String `tfsdk:"channel_id"`
+	FileIDs           types.Set    `tfsdk:"file_ids"`
+	ID                types.String `tfsdk:"id"`
+	MessageTimestamps types.Set    `tfsdk:"message_timestamps"`
+}
+
+// pinnedItems are the messages and files pinned in a conversation.
+type pinnedItems struct {
+	Files    []string
+	Messages []string
+}
+
+type resourceSlackConversationPins struct {
+	client *slackClient
+}
+
+func NewResourceSlackConversationPins() resource.Resource {
+	return &resourceSlackConversationPins{}
+}
+
+func (r *resourceSlackConversationPins) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackConversationPins) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_conversation_pins"
+}
+
+func (r *resourceSlackConversationPins) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_conversation_pins", &resp.Diagnostics) {
+		return
+	}
+
+	var data ConversationPins
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID, err := r.client.ResolveConversationID(data.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Channel Retrieval Error on Create",
+			fmt.Sprintf("Failed to retrieve conversation ID: %v", err),
+		)
+		return
+	}
+
+	planned := pinnedItemsFromModel(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := pinnedItems{}
+	if data.Authoritative.ValueBool() {
+		current, err = r.listPins(ctx, channelID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving Slack pins", err.Error())
+			return
+		}
+	}
+
+	if err := r.syncPins(ctx, channelID, current, planned, data.Authoritative.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Error updating Slack pins", err.Error())
+		return
+	}
+
+	data.ChannelID = types.StringValue(channelID)
+	data.ID = types.StringValue(channelID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Pinned Slack items", map[string]interface{}{
+		"channel_id": channelID,
+		"files":      len(planned.Files),
+		"messages":   len(planned.Messages),
+	})
+}
+
+func (r *resourceSlackConversationPins) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_conversation_pins", &resp.Diagnostics) {
+		return
+	}
+
+	var data ConversationPins
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := pinnedItemsFromModel(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncPins(ctx, data.ChannelID.ValueString(), current, pinnedItems{}, true)
+	if err != nil && !isSlackError(err, "channel_not_found") {
+		resp.Diagnostics.AddError("Error removing Slack pins", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Removed Slack pins", map[string]interface{}{
+		"channel_id": data.ChannelID.ValueString(),
+	})
+}
+
+func (r *resourceSlackConversationPins) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), true)...)
+}
+
+func (r *resourceSlackConversationPins) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationPins
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.listPins(ctx, data.ChannelID.ValueString())
+	if err != nil {
+		if isSlackError(err, "channel_not_found") {
+			tflog.Warn(ctx, "Slack conversation not found, removing its pins from state", map[string]interface{}{
+				"channel_id": data.ChannelID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack pins", err.Error())
+		return
+	}
+
+	// in additive mode, pins not managed by this resource are left out of the state
+	if !data.Authoritative.ValueBool() {
+		managed := pinnedItemsFromModel(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current = pinnedItems{
+			Files:    intersectStrings(current.Files, managed.Files),
+			Messages: intersectStrings(current.Messages, managed.Messages),
+		}
+	}
+
+	data.FileIDs = pinnedItemsToSet(ctx, current.Files, data.FileIDs, &resp.Diagnostics)
+	data.MessageTimestamps = pinnedItemsToSet(ctx, current.Messages, data.MessageTimestamps, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack pins", map[string]interface{}{
+		"channel_id": data.ChannelID.ValueString(),
+	})
+}
+
+func (r *resourceSlackConversationPins) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_conversation_pins** resource manages the messages and files pinned in a Slack channel.
+
+When ` + "`authoritative`" + ` is ` + "`true`" + ` (the default), the resource manages the whole pin set of the channel and unpins any item that is not configured. When ` + "`false`" + `, it only pins the configured items and leaves other pins alone, so several resources can pin items in the same channel.
+
+Message timestamps are usually taken from the ` + "`ts`" + ` attribute of a **slack_message** resource. Do not also set ` + "`pin`" + ` on a message managed by an authoritative pin set.
+
+Import is supported using the channel ID, which imports the resource in authoritative mode.
+
+**Required scopes**
+
+Bot tokens: pins:read, pins:write, channels:read, groups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Whether the resource manages all pins in the channel, unpinning items that are not configured. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the channel to pin the items in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessResolvesTo(&r.client, path.Root("channel_id"), (*slackClient).ResolveConversationID),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the files to pin.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message_timestamps": schema.SetAttribute{
+				MarkdownDescription: "The timestamps of the messages to pin.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackConversationPins) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_conversation_pins", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state ConversationPins
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := state.ChannelID.ValueString()
+
+	planned := pinnedItemsFromModel(ctx, data, &resp.Diagnostics)
+	current := pinnedItemsFromModel(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Authoritative.ValueBool() && !state.Authoritative.ValueBool() {
+		// the state only holds the managed pins, so look up the rest before taking them over
+		var err error
+		current, err = r.listPins(ctx, channelID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving Slack pins", err.Error())
+			return
+		}
+	}
+
+	// when switching to additive mode the state holds every pin, so nothing is unpinned
+	unpin := data.Authoritative.ValueBool() || !state.Authoritative.ValueBool()
+
+	if err := r.syncPins(ctx, channelID, current, planned, unpin); err != nil {
+		resp.Diagnostics.AddError("Error updating Slack pins", err.Error())
+		return
+	}
+
+	data.ChannelID = state.ChannelID
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack pins", map[string]interface{}{
+		"channel_id": channelID,
+	})
+}
+
+// listPins returns the messages and files pinned in a conversation.
+func (r *resourceSlackConversationPins) listPins(ctx context.Context, channelID string) (pinnedItems, error) {
+	items, _, err := r.client.ListPinsContext(ctx, channelID)
+	if err != nil {
+		// pins.list reports an empty channel as an error
+		if isSlackError(err, "no_pins") {
+			return pinnedItems{}, nil
+		}
+		return pinnedItems{}, err
+	}
+
+	pins := pinnedItems{}
+	for _, item := range items {
+		switch {
+		case item.Message != nil:
+			pins.Messages = append(pins.Messages, item.Message.Timestamp)
+		case item.File != nil:
+			pins.Files = append(pins.Files, item.File.ID)
+		}
+	}
+
+	return pins, nil
+}
+
+// syncPins pins the planned items that are not in current and, when unpin is set,
+// unpins the current items that are not planned.
+func (r *resourceSlackConversationPins) syncPins(ctx context.Context, channelID string, current pinnedItems, planned pinnedItems, unpin bool) error {
+	var add, remove []slack.ItemRef
+
+	for _, ts := range planned.Messages {
+		if !slices.Contains(current.Messages, ts) {
+			add = append(add, slack.NewRefToMessage(channelID, ts))
+		}
+	}
+	for _, file := range planned.Files {
+		if !slices.Contains(current.Files, file) {
+			add = append(add, slack.NewRefToFile(file))
+		}
+	}
+
+	if unpin {
+		for _, ts := range current.Messages {
+			if !slices.Contains(planned.Messages, ts) {
+				remove = append(remove, slack.NewRefToMessage(channelID, ts))
+			}
+		}
+		for _, file := range current.Files {
+			if !slices.Contains(planned.Files, file) {
+				remove = append(remove, slack.NewRefToFile(file))
+			}
+		}
+	}
+
+	for _, item := range remove {
+		if err := r.client.RemovePinContext(ctx, channelID, item); err != nil && !isSlackError(err, "no_pin", "message_not_found", "file_not_found") {
+			return err
+		}
+	}
+
+	for _, item := range add {
+		if err := r.client.AddPinContext(ctx, channelID, item); err != nil && !isSlackError(err, "already_pinned") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pinnedItemsFromModel returns the files and messages set in the model.
+func pinnedItemsFromModel(ctx context.Context, data ConversationPins, diags *diag.Diagnostics) pinnedItems {
+	pins := pinnedItems{}
+	if !data.FileIDs.IsNull() && !data.FileIDs.IsUnknown() {
+		diags.Append(data.FileIDs.ElementsAs(ctx, &pins.Files, false)...)
+	}
+	if !data.MessageTimestamps.IsNull() && !data.MessageTimestamps.IsUnknown() {
+		diags.Append(data.MessageTimestamps.ElementsAs(ctx, &pins.Messages, false)...)
+	}
+	return pins
+}
+
+// pinnedItemsToSet returns the items as a set, keeping an unset attribute null when nothing is pinned.
+func pinnedItemsToSet(ctx context.Context, items []string, previous types.Set, diags *diag.Diagnostics) types.Set {
+	if len(items) == 0 && previous.IsNull() {
+		return previous
+	}
+	if items == nil {
+		items = []string{}
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, items)
+	diags.Append(d...)
+	return set
+}
+
+// intersectStrings returns the values of a that are also in b.
+func intersectStrings(a []string, b []string) []string {
+	var result []string
+	for _, value := range a {
+		if slices.Contains(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_conversation_pins(t *testing.T) {
+	// local stand-in keeping the pinned message timestamps in memory, starting with a pin made outside Terraform
+	var mu sync.Mutex
+	pinned := []string{"1700000000.000001"}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0GENERAL", "name": "general", "is_channel": true}], "response_metadata": {"next_cursor": ""}}`
+			},
+			"pins.add": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if slices.Contains(pinned, r.Form.Get("timestamp")) {
+					return `{"ok": false, "error": "already_pinned"}`
+				}
+				pinned = append(pinned, r.Form.Get("timestamp"))
+				return `{"ok": true}`
+			},
+			"pins.remove": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				pinned = slices.DeleteFunc(pinned, func(ts string) bool { return ts == r.Form.Get("timestamp") })
+				return `{"ok": true}`
+			},
+			"pins.list": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				items := []any{}
+				for _, ts := range pinned {
+					items = append(items, map[string]any{"type": "message", "channel": "C0GENERAL", "message": map[string]any{"type": "message", "ts": ts}})
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "items": items})
+				return string(body)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				// additive mode leaves the existing pin alone
+				Config: testSlackConversationPinsConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_conversation_pins.test", "channel_id", "C0GENERAL"),
+					resource.TestCheckResourceAttr("slack_conversation_pins.test", "message_timestamps.#", "1"),
+					testCheckStandIn(&mu, func() error {
+						if len(pinned) != 2 {
+							return fmt.Errorf("expected 2 pinned messages, got %v", pinned)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// authoritative mode unpins it
+				Config: testSlackConversationPinsConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_conversation_pins.test", "message_timestamps.#", "1"),
+					resource.TestCheckTypeSetElemAttr("slack_conversation_pins.test", "message_timestamps.*", "1700000000.000100"),
+					testCheckStandIn(&mu, func() error {
+						if !slices.Equal(pinned, []string{"1700000000.000100"}) {
+							return fmt.Errorf("expected only the configured message to be pinned, got %v", pinned)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            "slack_conversation_pins.test",
+				ImportState:             true,
+				ImportStateId:           "C0GENERAL",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"channel"},
+			},
+		},
+	})
+}
+
+func testSlackConversationPinsConfig(authoritative bool) string {
+	return fmt.Sprintf(`
+        resource "slack_conversation_pins" "test" {
+            channel            = "general"
+            authoritative      = %t
+            message_timestamps = ["1700000000.000100"]
+        }
+    `, authoritative)
+}
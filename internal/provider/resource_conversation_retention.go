@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackConversationRetention)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackConversationRetention)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackConversationRetention)(nil)
+)
+
+type ConversationRetention struct {
+	Channel      types.String `tfsdk:"channel"`
+	ChannelID    types.String `tfsdk:"channel_id"`
+	DurationDays types.Int64  `tfsdk:"duration_days"`
+	ID           types.String `tfsdk:"id"`
+}
+
+type resourceSlackConversationRetention struct {
+	client *slackClient
+}
+
+func NewResourceSlackConversationRetention() resource.Resource {
+	return &resourceSlackConversationRetention{}
+}
+
+func (r *resourceSlackConversationRetention) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackConversationRetention) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_conversation_retention"
+}
+
+func (r *resourceSlackConversationRetention) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConversationRetention
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DurationDays.IsNull() && !data.DurationDays.IsUnknown() && data.DurationDays.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("duration_days"), "Invalid Retention Duration", "The retention duration must be at least 1 day.")
+	}
+}
+
+func (r *resourceSlackConversationRetention) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_conversation_retention", &resp.Diagnostics) {
+		return
+	}
+
+	var data ConversationRetention
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID, err := r.client.ResolveConversationID(data.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("channel"), "Error Retrieving Conversation", err.Error())
+		return
+	}
+	data.ChannelID = types.StringValue(channelID)
+	data.ID = types.StringValue(channelID)
+
+	if err := r.setRetention(ctx, channelID, data.DurationDays.ValueInt64()); err != nil {
+		addRetentionError(&resp.Diagnostics, "Error setting Slack conversation retention", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Set Slack conversation retention", map[string]interface{}{
+		"channel_id":    channelID,
+		"duration_days": data.DurationDays.ValueInt64(),
+	})
+}
+
+func (r *resourceSlackConversationRetention) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_conversation_retention", &resp.Diagnostics) {
+		return
+	}
+
+	var data ConversationRetention
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Call(ctx, "admin.conversations.removeCustomRetention", url.Values{"channel_id": {data.ChannelID.ValueString()}}, nil)
+	if err != nil {
+		if isSlackError(err, "channel_not_found") {
+			tflog.Warn(ctx, "Slack conversation not found, assuming its retention was already removed", map[string]interface{}{
+				"channel_id": data.ChannelID.ValueString(),
+			})
+			return
+		}
+		addRetentionError(&resp.Diagnostics, "Error removing Slack conversation retention", err)
+		return
+	}
+
+	tflog.Trace(ctx, "Removed Slack conversation retention", map[string]interface{}{
+		"channel_id": data.ChannelID.ValueString(),
+	})
+}
+
+func (r *resourceSlackConversationRetention) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+}
+
+func (r *resourceSlackConversationRetention) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationRetention
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		IsPolicyEnabled bool  `json:"is_policy_enabled"`
+		DurationDays    int64 `json:"duration_days"`
+	}
+	err := r.client.Call(ctx, "admin.conversations.getCustomRetention", url.Values{"channel_id": {data.ChannelID.ValueString()}}, &result)
+	if err != nil && !isSlackError(err, "channel_not_found") {
+		addRetentionError(&resp.Diagnostics, "Error retrieving Slack conversation retention", err)
+		return
+	}
+
+	if err != nil || !result.IsPolicyEnabled {
+		tflog.Warn(ctx, "Slack conversation has no custom retention, removing it from state", map[string]interface{}{
+			"channel_id": data.ChannelID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.DurationDays = types.Int64Value(result.DurationDays)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackConversationRetention) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_conversation_retention** resource manages a custom message retention policy of a conversation, overriding the retention of its workspace.
+
+Custom retention requires an Enterprise Grid plan that lets admins override the retention of conversations. Destroying the resource removes the custom retention, so the conversation follows the retention of its workspace again.
+
+Slack has no Web API method to manage the retention of a workspace, which must be set in the admin dashboard.
+
+Import is supported using the conversation ID.
+
+**Required scopes**
+
+User tokens: admin.conversations:read, admin.conversations:write, channels:read, groups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the conversation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessResolvesTo(&r.client, path.Root("channel_id"), (*slackClient).ResolveConversationID),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days messages and files are kept.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the conversation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackConversationRetention) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_conversation_retention", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state ConversationRetention
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ChannelID = state.ChannelID
+	data.ID = state.ID
+
+	if err := r.setRetention(ctx, data.ChannelID.ValueString(), data.DurationDays.ValueInt64()); err != nil {
+		addRetentionError(&resp.Diagnostics, "Error setting Slack conversation retention", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack conversation retention", map[string]interface{}{
+		"channel_id":    data.ChannelID.ValueString(),
+		"duration_days": data.DurationDays.ValueInt64(),
+	})
+}
+
+func (r *resourceSlackConversationRetention) setRetention(ctx context.Context, channelID string, durationDays int64) error {
+	return r.client.Call(ctx, "admin.conversations.setCustomRetention", url.Values{
+		"channel_id":    {channelID},
+		"duration_days": {strconv.FormatInt(durationDays, 10)},
+	}, nil)
+}
+
+// addRetentionError adds err to diags, explaining the errors Slack returns when the plan or
+// the organization settings do not allow custom retention.
+func addRetentionError(diags *diag.Diagnostics, summary string, err error) {
+	switch {
+	case isSlackError(err, "paid_only"):
+		diags.AddError(summary, "Custom message retention is only available on Enterprise Grid plans. Slack returned: "+err.Error())
+	case isSlackError(err, "feature_not_enabled", "not_allowed"):
+		diags.AddError(summary, "Custom message retention is not enabled for this organization. Allow workspace owners or admins to override retention for conversations in the admin dashboard. Slack returned: "+err.Error())
+	default:
+		diags.AddError(summary, err.Error())
+	}
+}
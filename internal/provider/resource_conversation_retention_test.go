@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_conversation_retention(t *testing.T) {
+	// local stand-in keeping the custom retention of each channel in memory
+	var mu sync.Mutex
+	retention := map[string]int{}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0LEGAL", "name": "legal", "is_channel": true}, {"id": "C0FREE", "name": "free", "is_channel": true}]}`
+			},
+			"admin.conversations.setCustomRetention": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if r.Form.Get("channel_id") == "C0FREE" {
+					return `{"ok": false, "error": "paid_only"}`
+				}
+				days, _ := strconv.Atoi(r.Form.Get("duration_days"))
+				retention[r.Form.Get("channel_id")] = days
+				return `{"ok": true}`
+			},
+			"admin.conversations.getCustomRetention": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				days, ok := retention[r.Form.Get("channel_id")]
+				return fmt.Sprintf(`{"ok": true, "is_policy_enabled": %t, "duration_days": %d}`, ok, days)
+			},
+			"admin.conversations.removeCustomRetention": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				delete(retention, r.Form.Get("channel_id"))
+				return `{"ok": true}`
+			},
+		},
+		checkDestroy: testCheckStandIn(&mu, func() error {
+			if len(retention) > 0 {
+				return fmt.Errorf("expected no custom retention, got %v", retention)
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config:      testSlackConversationRetentionConfig("legal", 0),
+				ExpectError: regexp.MustCompile("at least 1 day"),
+			},
+			{
+				Config:      testSlackConversationRetentionConfig("free", 30),
+				ExpectError: regexp.MustCompile("only available on Enterprise Grid"),
+			},
+			{
+				Config: testSlackConversationRetentionConfig("legal", 365),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_conversation_retention.test", "id", "C0LEGAL"),
+					resource.TestCheckResourceAttr("slack_conversation_retention.test", "duration_days", "365"),
+				),
+			},
+			{
+				// a retention changed outside Terraform is set again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					retention["C0LEGAL"] = 30
+				},
+				Config: testSlackConversationRetentionConfig("legal", 365),
+				Check: testCheckStandIn(&mu, func() error {
+					if retention["C0LEGAL"] != 365 {
+						return fmt.Errorf("expected a retention of 365 days, got %d", retention["C0LEGAL"])
+					}
+					return nil
+				}),
+			},
+			{
+				ResourceName:            "slack_conversation_retention.test",
+				ImportState:             true,
+				ImportStateId:           "C0LEGAL",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"channel"},
+			},
+		},
+	})
+}
+
+func testSlackConversationRetentionConfig(channel string, durationDays int) string {
+	return fmt.Sprintf(`
+        resource "slack_conversation_retention" "test" {
+            channel       = "%s"
+            duration_days = %d
+        }
+    `, channel, durationDays)
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackEmoji)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackEmoji)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackEmoji)(nil)
+)
+
+// emojiAliasPrefix marks an alias in the emoji.list response, e.g. "alias:thumbsup".
+const emojiAliasPrefix = "alias:"
+
+type Emoji struct {
+	AliasFor types.String `tfsdk:"alias_for"`
+	File     types.String `tfsdk:"file"`
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	URL      types.String `tfsdk:"url"`
+}
+
+type resourceSlackEmoji struct {
+	client *slackClient
+}
+
+func NewResourceSlackEmoji() resource.Resource {
+	return &resourceSlackEmoji{}
+}
+
+func (r *resourceSlackEmoji) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackEmoji) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_emoji"
+}
+
+func (r *resourceSlackEmoji) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data Emoji
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// unknown values may still resolve to null, so only count the sources that are known
+	sources := 0
+	for _, source := range []types.String{data.AliasFor, data.File, data.URL} {
+		if !source.IsNull() {
+			sources++
+		}
+	}
+	if sources != 1 && !data.AliasFor.IsUnknown() && !data.File.IsUnknown() && !data.URL.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Invalid Emoji Source",
+			"Exactly one of `url`, `file` or `alias_for` must be set.",
+		)
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() && strings.Contains(data.Name.ValueString(), ":") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Emoji Name",
+			fmt.Sprintf("The emoji name must not contain colons, use %q instead of %q.", strings.Trim(data.Name.ValueString(), ":"), data.Name.ValueString()),
+		)
+	}
+}
+
+func (r *resourceSlackEmoji) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_emoji", &resp.Diagnostics) {
+		return
+	}
+
+	var data Emoji
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	var err error
+	switch {
+	case !data.AliasFor.IsNull():
+		err = r.client.Call(ctx, "admin.emoji.addAlias", url.Values{
+			"name":      {name},
+			"alias_for": {data.AliasFor.ValueString()},
+		}, nil)
+	case !data.URL.IsNull():
+		err = r.client.Call(ctx, "admin.emoji.add", url.Values{
+			"name": {name},
+			"url":  {data.URL.ValueString()},
+		}, nil)
+	default:
+		// admin.emoji.add only takes a URL, so local images are uploaded with emoji.add
+		image, readErr := os.ReadFile(data.File.ValueString())
+		if readErr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("file"),
+				"Error Reading Emoji Image",
+				fmt.Sprintf("Failed to read %s: %s", data.File.ValueString(), readErr.Error()),
+			)
+			return
+		}
+		err = r.client.CallMultipart(ctx, "emoji.add", url.Values{
+			"name": {name},
+			"mode": {"data"},
+		}, "image", filepath.Base(data.File.ValueString()), image, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error adding Slack emoji", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Added Slack emoji", map[string]interface{}{
+		"name": name,
+	})
+}
+
+func (r *resourceSlackEmoji) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_emoji", &resp.Diagnostics) {
+		return
+	}
+
+	var data Emoji
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Call(ctx, "admin.emoji.remove", url.Values{"name": {data.ID.ValueString()}}, nil)
+	if err != nil {
+		if isSlackError(err, "emoji_not_found") {
+			tflog.Warn(ctx, "Slack emoji not found, assuming it was already removed", map[string]interface{}{
+				"name": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error removing Slack emoji", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Removed Slack emoji", map[string]interface{}{
+		"name": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackEmoji) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+func (r *resourceSlackEmoji) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data Emoji
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emojis, err := r.client.GetEmojiContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing Slack emoji", err.Error())
+		return
+	}
+
+	value, ok := emojis[data.ID.ValueString()]
+	if !ok {
+		tflog.Warn(ctx, "Slack emoji not found, removing it from state", map[string]interface{}{
+			"name": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Slack hosts a copy of the image, so only aliases can be checked for drift
+	aliasFor, isAlias := strings.CutPrefix(value, emojiAliasPrefix)
+	switch {
+	case isAlias:
+		data.AliasFor = types.StringValue(aliasFor)
+	case data.URL.IsNull() && data.File.IsNull():
+		// imported image emoji keep the URL of the copy Slack hosts
+		data.AliasFor = types.StringNull()
+		data.URL = types.StringValue(value)
+	default:
+		data.AliasFor = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack emoji", map[string]interface{}{
+		"name": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackEmoji) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_emoji** resource manages a custom emoji, added from an image URL, from a local image file or as an alias of another emoji.
+
+Renaming the emoji is done in place. Changing its image or alias replaces it. Emoji are added and removed with the ` + "`admin.emoji`" + ` methods, which require an Enterprise Grid org admin. Images from a local file are uploaded with the workspace ` + "`emoji.add`" + ` method instead, as ` + "`admin.emoji.add`" + ` only takes a URL.
+
+Import is supported using the emoji name.
+
+**Required scopes**
+
+User tokens: admin.teams:read, admin.teams:write, emoji:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"alias_for": schema.StringAttribute{
+				MarkdownDescription: "The name of the emoji this emoji is an alias for, without colons.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path of a local image file to upload. Changes to the file content are not detected, change the path to upload a new image.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The name of the emoji.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the emoji, without colons (e.g. `partyparrot`).",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the image to add the emoji from.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackEmoji) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_emoji", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state Emoji
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.Equal(state.Name) {
+		err := r.client.Call(ctx, "admin.emoji.rename", url.Values{
+			"name":     {state.ID.ValueString()},
+			"new_name": {data.Name.ValueString()},
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error renaming Slack emoji", err.Error())
+			return
+		}
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Renamed Slack emoji", map[string]interface{}{
+		"from": state.ID.ValueString(),
+		"to":   data.ID.ValueString(),
+	})
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_emoji(t *testing.T) {
+	// local stand-in keeping the custom emoji in memory
+	var mu sync.Mutex
+	emojis := map[string]string{}
+
+	image := filepath.Join(t.TempDir(), "shipit.png")
+	if err := os.WriteFile(image, []byte("\x89PNG"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"emoji.add": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if _, _, err := r.FormFile("image"); err != nil {
+					return `{"ok": false, "error": "no_image_uploaded"}`
+				}
+				emojis[r.Form.Get("name")] = "https://emoji.slack-edge.com/T0TEAM/" + r.Form.Get("name") + ".png"
+				return `{"ok": true}`
+			},
+			"admin.emoji.add": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if r.Form.Get("url") == "" {
+					return `{"ok": false, "error": "invalid_url"}`
+				}
+				emojis[r.Form.Get("name")] = "https://emoji.slack-edge.com/T0TEAM/" + r.Form.Get("name") + ".png"
+				return `{"ok": true}`
+			},
+			"admin.emoji.addAlias": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				emojis[r.Form.Get("name")] = "alias:" + r.Form.Get("alias_for")
+				return `{"ok": true}`
+			},
+			"admin.emoji.rename": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				emojis[r.Form.Get("new_name")] = emojis[r.Form.Get("name")]
+				delete(emojis, r.Form.Get("name"))
+				return `{"ok": true}`
+			},
+			"admin.emoji.remove": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				delete(emojis, r.Form.Get("name"))
+				return `{"ok": true}`
+			},
+			"emoji.list": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				body, _ := json.Marshal(map[string]any{"ok": true, "emoji": emojis})
+				return string(body)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: testSlackEmojiConfig("shipit", image),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_emoji.image", "id", "shipit"),
+					resource.TestCheckResourceAttr("slack_emoji.url", "id", "partyparrot"),
+					resource.TestCheckResourceAttr("slack_emoji.alias", "alias_for", "shipit"),
+				),
+			},
+			{
+				Config: testSlackEmojiConfig("ship_it", image),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_emoji.image", "id", "ship_it"),
+					resource.TestCheckResourceAttr("slack_emoji.alias", "alias_for", "ship_it"),
+				),
+			},
+			{
+				ResourceName:      "slack_emoji.alias",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testSlackEmojiConfig(name string, image string) string {
+	return fmt.Sprintf(`
+        resource "slack_emoji" "image" {
+            name = "%s"
+            file = "%s"
+        }
+
+        resource "slack_emoji" "url" {
+            name = "partyparrot"
+            url  = "https://example.com/emoji/partyparrot.gif"
+        }
+
+        resource "slack_emoji" "alias" {
+            name      = "squirrel"
+            alias_for = slack_emoji.image.id
+        }
+    `, name, image)
+}
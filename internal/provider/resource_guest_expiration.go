@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-slack/internal/slackutil"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackGuestExpiration)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackGuestExpiration)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackGuestExpiration)(nil)
+)
+
+const (
+	guestTypeMultiChannel  = "multi_channel"
+	guestTypeSingleChannel = "single_channel"
+)
+
+type GuestExpiration struct {
+	ChannelIDs types.Set    `tfsdk:"channel_ids"`
+	Expiration types.String `tfsdk:"expiration"`
+	GuestType  types.String `tfsdk:"guest_type"`
+	ID         types.String `tfsdk:"id"`
+	TeamID     types.String `tfsdk:"team_id"`
+	User       types.String `tfsdk:"user"`
+	UserID     types.String `tfsdk:"user_id"`
+}
+
+type resourceSlackGuestExpiration struct {
+	client *slackClient
+}
+
+func NewResourceSlackGuestExpiration() resource.Resource {
+	return &resourceSlackGuestExpiration{}
+}
+
+func (r *resourceSlackGuestExpiration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackGuestExpiration) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_guest_expiration"
+}
+
+func (r *resourceSlackGuestExpiration) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GuestExpiration
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Expiration.IsNull() && !data.Expiration.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.Expiration.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration"),
+				"Invalid Expiration",
+				fmt.Sprintf("The expiration must be an RFC3339 time such as 2030-01-31T17:00:00Z: %s", err.Error()),
+			)
+		}
+	}
+
+	if data.GuestType.IsNull() || data.GuestType.IsUnknown() {
+		return
+	}
+	switch data.GuestType.ValueString() {
+	case guestTypeMultiChannel:
+	case guestTypeSingleChannel:
+		if !data.ChannelIDs.IsUnknown() && len(data.ChannelIDs.Elements()) != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("channel_ids"),
+				"Invalid Guest Channels",
+				"A single-channel guest must have exactly one channel in channel_ids.",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("guest_type"),
+			"Invalid Guest Type",
+			fmt.Sprintf("The guest type must be one of %s, %s, got %q.", guestTypeMultiChannel, guestTypeSingleChannel, data.GuestType.ValueString()),
+		)
+	}
+}
+
+func (r *resourceSlackGuestExpiration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_guest_expiration", &resp.Diagnostics) {
+		return
+	}
+
+	var data GuestExpiration
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs, err := r.client.ResolveUserIDs([]string{data.User.ValueString()})
+	if err != nil || len(userIDs) != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("user"), "Error Retrieving User", fmt.Sprintf("Failed to resolve user %q: %v", data.User.ValueString(), err))
+		return
+	}
+	data.UserID = types.StringValue(userIDs[0])
+	data.ID = types.StringValue(userIDs[0])
+
+	// Compute `team_id` if it’s not defined, inheriting the provider default first
+	teamID := r.client.ResolveTeamID(data.TeamID)
+	if teamID == "" {
+		teamInfo, err := slackutil.GetTeamInfo(r.client.Client, "")
+		if err != nil {
+			resp.Diagnostics.AddError("Team ID Retrieval Error", fmt.Sprintf("Failed to compute team ID: %v", err))
+			return
+		}
+		teamID = teamInfo.ID
+	}
+	data.TeamID = types.StringValue(teamID)
+
+	user, err := r.client.GetUserInfoContext(ctx, userIDs[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack user", err.Error())
+		return
+	}
+	guest := newUserModel(*user)
+	if !guest.IsRestricted.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user"),
+			"User Is Not a Guest",
+			fmt.Sprintf("The user %s is a full member, only guest accounts can expire.", userIDs[0]),
+		)
+		return
+	}
+
+	if !r.apply(ctx, &data, guestType(guest), "", &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Set Slack guest expiration", map[string]interface{}{
+		"user_id":    data.UserID.ValueString(),
+		"expiration": data.Expiration.ValueString(),
+	})
+}
+
+// Delete only removes the expiration from the state, as Slack has no method to clear it.
+func (r *resourceSlackGuestExpiration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_guest_expiration", &resp.Diagnostics) {
+		return
+	}
+
+	var data GuestExpiration
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Slack guest expiration is left in place", map[string]interface{}{
+		"user_id": data.UserID.ValueString(),
+	})
+}
+
+func (r *resourceSlackGuestExpiration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID, teamID, _ := strings.Cut(req.ID, "/")
+	if userID == "" || teamID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form user_id/team_id, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+}
+
+func (r *resourceSlackGuestExpiration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GuestExpiration
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUserInfoContext(ctx, data.UserID.ValueString())
+	if err != nil && !isSlackError(err, "user_not_found") {
+		resp.Diagnostics.AddError("Error retrieving Slack user", err.Error())
+		return
+	}
+
+	// an expired, deactivated or promoted guest has no expiration any more
+	if err != nil {
+		tflog.Warn(ctx, "Slack user not found, removing the guest expiration from state", map[string]interface{}{
+			"user_id": data.UserID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	guest := newUserModel(*user)
+	if guest.Deleted.ValueBool() || !guest.IsRestricted.ValueBool() {
+		tflog.Warn(ctx, "Slack user is no longer an active guest, removing the expiration from state", map[string]interface{}{
+			"user_id": data.UserID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.GuestType = types.StringValue(guestType(guest))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackGuestExpiration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_guest_expiration** resource sets the date a guest account expires, and converts guests between multi-channel and single-channel guests.
+
+The guest type is read from the ` + "`is_restricted`" + ` and ` + "`is_ultra_restricted`" + ` flags of the user. Guests are converted with ` + "`admin.users.assign`" + `, which also assigns them to the ` + "`channel_ids`" + `. Once the guest has expired, been deactivated or been made a full member, the resource is removed from the state. Slack does not report the expiration date, so changes to it made outside Terraform are not detected. Destroying the resource leaves the expiration in place.
+
+Import is supported using ` + "`user_id/team_id`" + `.
+
+**Required scopes**
+
+User tokens: admin.users:write, users:read, users:read.email
+`,
+		Attributes: map[string]schema.Attribute{
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the channels the guest is assigned to when its `guest_type` is changed. A single-channel guest must have exactly one.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"expiration": schema.StringAttribute{
+				MarkdownDescription: "When the guest account expires, as an RFC3339 time such as `2030-01-31T17:00:00Z`.",
+				Required:            true,
+			},
+			"guest_type": schema.StringAttribute{
+				MarkdownDescription: "Whether the user is a `multi_channel` or `single_channel` guest. Defaults to the current type of the guest.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the guest.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace of the guest. Defaults to the provider `team_id`, or the workspace of the token.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The email address or ID of the guest.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the guest.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackGuestExpiration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_guest_expiration", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state GuestExpiration
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.UserID = state.UserID
+	data.TeamID = state.TeamID
+
+	if !r.apply(ctx, &data, state.GuestType.ValueString(), state.Expiration.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack guest expiration", map[string]interface{}{
+		"user_id":    data.UserID.ValueString(),
+		"expiration": data.Expiration.ValueString(),
+	})
+}
+
+// apply converts the guest when the planned guest type differs from the current one and
+// sets the expiration when it differs from the current one, which is empty on create.
+func (r *resourceSlackGuestExpiration) apply(ctx context.Context, data *GuestExpiration, currentType string, currentExpiration string, diags *diag.Diagnostics) bool {
+	userID := data.UserID.ValueString()
+	teamID := data.TeamID.ValueString()
+
+	if data.GuestType.IsUnknown() || data.GuestType.IsNull() {
+		data.GuestType = types.StringValue(currentType)
+	}
+
+	if data.GuestType.ValueString() != currentType {
+		var channelIDs []string
+		if !data.ChannelIDs.IsNull() {
+			diags.Append(data.ChannelIDs.ElementsAs(ctx, &channelIDs, false)...)
+			if diags.HasError() {
+				return false
+			}
+		}
+
+		values := url.Values{
+			"team_id":             {teamID},
+			"user_id":             {userID},
+			"is_restricted":       {"true"},
+			"is_ultra_restricted": {strconv.FormatBool(data.GuestType.ValueString() == guestTypeSingleChannel)},
+		}
+		if len(channelIDs) > 0 {
+			values.Set("channel_ids", strings.Join(channelIDs, ","))
+		}
+		if err := r.client.Call(ctx, "admin.users.assign", values, nil); err != nil {
+			diags.AddError("Error converting Slack guest", err.Error())
+			return false
+		}
+	}
+
+	if data.Expiration.ValueString() == currentExpiration {
+		return true
+	}
+
+	expiration, err := time.Parse(time.RFC3339, data.Expiration.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("expiration"), "Invalid Expiration", err.Error())
+		return false
+	}
+	if err := r.client.Call(ctx, "admin.users.setExpiration", url.Values{
+		"team_id":       {teamID},
+		"user_id":       {userID},
+		"expiration_ts": {strconv.FormatInt(expiration.Unix(), 10)},
+	}, nil); err != nil {
+		diags.AddError("Error setting Slack guest expiration", err.Error())
+		return false
+	}
+
+	return true
+}
+
+// guestType returns the guest type of a guest user.
+func guestType(user User) string {
+	if user.IsUltraRestricted.ValueBool() {
+		return guestTypeSingleChannel
+	}
+	return guestTypeMultiChannel
+}
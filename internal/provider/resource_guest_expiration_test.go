@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_guest_expiration(t *testing.T) {
+	// local stand-in keeping the guest flags and expiration of each user in memory
+	var mu sync.Mutex
+	restricted := map[string]bool{"U0GUEST": true}
+	ultraRestricted := map[string]bool{}
+	expirations := map[string]string{}
+
+	checkGuest := func(wantUltraRestricted bool, wantExpiration string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if ultraRestricted["U0GUEST"] != wantUltraRestricted {
+				return fmt.Errorf("expected is_ultra_restricted %t, got %t", wantUltraRestricted, ultraRestricted["U0GUEST"])
+			}
+			if expirations["U0GUEST"] != wantExpiration {
+				return fmt.Errorf("expected expiration %q, got %q", wantExpiration, expirations["U0GUEST"])
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		providerConfig: `team_id = "T0TEAM"`,
+		handlers: map[string]func(r *http.Request) string{
+			"users.list": func(r *http.Request) string {
+				return `{"ok": true, "members": [{"id": "U0GUEST", "profile": {"email": "contractor@example.com"}}, {"id": "U0MEMBER", "profile": {"email": "member@example.com"}}]}`
+			},
+			"users.info": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				userID := r.Form.Get("user")
+				body, _ := json.Marshal(map[string]any{"ok": true, "user": map[string]any{
+					"id": userID, "is_restricted": restricted[userID], "is_ultra_restricted": ultraRestricted[userID],
+				}})
+				return string(body)
+			},
+			"admin.users.assign": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if r.Form.Get("team_id") != "T0TEAM" {
+					return `{"ok": false, "error": "invalid_team_id"}`
+				}
+				userID := r.Form.Get("user_id")
+				restricted[userID] = r.Form.Get("is_restricted") == "true"
+				ultraRestricted[userID] = r.Form.Get("is_ultra_restricted") == "true"
+				return `{"ok": true}`
+			},
+			"admin.users.setExpiration": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				expirations[r.Form.Get("user_id")] = r.Form.Get("expiration_ts")
+				return `{"ok": true}`
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config:      testSlackGuestExpirationConfig("member@example.com", "2030-01-31T17:00:00Z", ""),
+				ExpectError: regexp.MustCompile("only guest accounts can expire"),
+			},
+			{
+				Config:      testSlackGuestExpirationConfig("contractor@example.com", "2030-01-31T17:00:00Z", `guest_type = "single_channel"`),
+				ExpectError: regexp.MustCompile("exactly one channel"),
+			},
+			{
+				Config: testSlackGuestExpirationConfig("contractor@example.com", "2030-01-31T17:00:00Z", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_guest_expiration.test", "id", "U0GUEST"),
+					resource.TestCheckResourceAttr("slack_guest_expiration.test", "guest_type", "multi_channel"),
+					checkGuest(false, "1896109200"),
+				),
+			},
+			{
+				Config: testSlackGuestExpirationConfig("contractor@example.com", "2030-02-28T17:00:00Z", `
+                    guest_type  = "single_channel"
+                    channel_ids = ["C0PROJECT"]
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_guest_expiration.test", "guest_type", "single_channel"),
+					checkGuest(true, "1898528400"),
+				),
+			},
+			{
+				// a guest converted outside Terraform is converted again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					ultraRestricted["U0GUEST"] = false
+				},
+				Config: testSlackGuestExpirationConfig("contractor@example.com", "2030-02-28T17:00:00Z", `
+                    guest_type  = "single_channel"
+                    channel_ids = ["C0PROJECT"]
+                `),
+				Check: checkGuest(true, "1898528400"),
+			},
+			{
+				// a guest made a full member outside Terraform no longer expires
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					restricted["U0GUEST"] = false
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testSlackGuestExpirationConfig(user string, expiration string, settings string) string {
+	return fmt.Sprintf(`
+        resource "slack_guest_expiration" "test" {
+            user       = "%s"
+            expiration = "%s"
+            %s
+        }
+    `, user, expiration, settings)
+}
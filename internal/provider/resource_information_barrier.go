@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackInformationBarrier)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackInformationBarrier)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackInformationBarrier)(nil)
+)
+
+// informationBarrierSubjects are the kinds of communication an information barrier can restrict.
+var informationBarrierSubjects = []string{"call", "im", "mpim"}
+
+type InformationBarrier struct {
+	BarrieredFromUsergroupIDs types.Set    `tfsdk:"barriered_from_usergroup_ids"`
+	BarrieredFromUsergroups   types.Set    `tfsdk:"barriered_from_usergroups"`
+	ID                        types.String `tfsdk:"id"`
+	PrimaryUsergroup          types.String `tfsdk:"primary_usergroup"`
+	PrimaryUsergroupID        types.String `tfsdk:"primary_usergroup_id"`
+	RestrictedSubjects        types.Set    `tfsdk:"restricted_subjects"`
+}
+
+// informationBarrier is a barrier of the admin.barriers API.
+type informationBarrier struct {
+	ID               string `json:"id"`
+	PrimaryUsergroup struct {
+		ID string `json:"id"`
+	} `json:"primary_usergroup"`
+	BarrieredFromUsergroups []struct {
+		ID string `json:"id"`
+	} `json:"barriered_from_usergroups"`
+	RestrictedSubjects []string `json:"restricted_subjects"`
+}
+
+type resourceSlackInformationBarrier struct {
+	client *slackClient
+}
+
+func NewResourceSlackInformationBarrier() resource.Resource {
+	return &resourceSlackInformationBarrier{}
+}
+
+func (r *resourceSlackInformationBarrier) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackInformationBarrier) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_information_barrier"
+}
+
+func (r *resourceSlackInformationBarrier) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InformationBarrier
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.RestrictedSubjects.IsNull() || data.RestrictedSubjects.IsUnknown() {
+		return
+	}
+
+	var subjects []string
+	resp.Diagnostics.Append(data.RestrictedSubjects.ElementsAs(ctx, &subjects, false)...)
+	for _, subject := range subjects {
+		if !slices.Contains(informationBarrierSubjects, subject) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("restricted_subjects"),
+				"Invalid Restricted Subject",
+				fmt.Sprintf("The restricted subject must be one of %s, got %q.", strings.Join(informationBarrierSubjects, ", "), subject),
+			)
+		}
+	}
+}
+
+func (r *resourceSlackInformationBarrier) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_information_barrier", &resp.Diagnostics) {
+		return
+	}
+
+	var data InformationBarrier
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := r.barrierValues(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Barrier informationBarrier `json:"barrier"`
+	}
+	if err := r.client.Call(ctx, "admin.barriers.create", values, &result); err != nil {
+		resp.Diagnostics.AddError("Error creating Slack information barrier", err.Error())
+		return
+	}
+	data.ID = types.StringValue(result.Barrier.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Created Slack information barrier", map[string]interface{}{
+		"id":                   data.ID.ValueString(),
+		"primary_usergroup_id": data.PrimaryUsergroupID.ValueString(),
+	})
+}
+
+func (r *resourceSlackInformationBarrier) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_information_barrier", &resp.Diagnostics) {
+		return
+	}
+
+	var data InformationBarrier
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Call(ctx, "admin.barriers.delete", url.Values{"barrier_id": {data.ID.ValueString()}}, nil); err != nil {
+		if isSlackError(err, "barrier_not_found") {
+			tflog.Warn(ctx, "Slack information barrier not found, assuming it was already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Slack information barrier", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Slack information barrier", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackInformationBarrier) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceSlackInformationBarrier) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InformationBarrier
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	barrier, err := r.findBarrier(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack information barriers", err.Error())
+		return
+	}
+	if barrier == nil {
+		tflog.Warn(ctx, "Slack information barrier not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// usergroups are configured by handle or ID, so they are only replaced by the IDs when
+	// the barrier no longer matches the configuration
+	if barrier.PrimaryUsergroup.ID != data.PrimaryUsergroupID.ValueString() {
+		data.PrimaryUsergroup = types.StringValue(barrier.PrimaryUsergroup.ID)
+	}
+	data.PrimaryUsergroupID = types.StringValue(barrier.PrimaryUsergroup.ID)
+
+	barrieredIDs := make([]string, 0, len(barrier.BarrieredFromUsergroups))
+	for _, usergroup := range barrier.BarrieredFromUsergroups {
+		barrieredIDs = append(barrieredIDs, usergroup.ID)
+	}
+	barrieredIDsValue, diags := types.SetValueFrom(ctx, types.StringType, barrieredIDs)
+	resp.Diagnostics.Append(diags...)
+	if !barrieredIDsValue.Equal(data.BarrieredFromUsergroupIDs) {
+		data.BarrieredFromUsergroups = barrieredIDsValue
+	}
+	data.BarrieredFromUsergroupIDs = barrieredIDsValue
+
+	data.RestrictedSubjects, diags = types.SetValueFrom(ctx, types.StringType, barrier.RestrictedSubjects)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackInformationBarrier) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_information_barrier** resource manages an information barrier of an Enterprise Grid organization, which keeps the members of a primary user group from communicating with the members of other user groups.
+
+User groups are given by handle, name or ID.
+
+Import is supported using the barrier ID.
+
+**Required scopes**
+
+User tokens: admin.barriers:read, admin.barriers:write, usergroups:read, users:read, users:read.email
+`,
+		Attributes: map[string]schema.Attribute{
+			"barriered_from_usergroup_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the user groups the primary user group is barriered from.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"barriered_from_usergroups": schema.SetAttribute{
+				MarkdownDescription: "The handles, names or IDs of the user groups the primary user group is barriered from.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the information barrier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_usergroup": schema.StringAttribute{
+				MarkdownDescription: "The handle, name or ID of the user group the barrier applies to.",
+				Required:            true,
+			},
+			"primary_usergroup_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user group the barrier applies to.",
+				Computed:            true,
+			},
+			"restricted_subjects": schema.SetAttribute{
+				MarkdownDescription: "The kinds of communication that are restricted: `im`, `mpim` and `call`. Slack currently requires all three.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackInformationBarrier) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_information_barrier", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state InformationBarrier
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	values := r.barrierValues(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values.Set("barrier_id", data.ID.ValueString())
+
+	if err := r.client.Call(ctx, "admin.barriers.update", values, nil); err != nil {
+		resp.Diagnostics.AddError("Error updating Slack information barrier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack information barrier", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// barrierValues resolves the usergroups of the plan into its computed IDs and returns the
+// parameters of admin.barriers.create and admin.barriers.update.
+func (r *resourceSlackInformationBarrier) barrierValues(ctx context.Context, data *InformationBarrier, diags *diag.Diagnostics) url.Values {
+	primaryID, err := r.client.ResolveUserGroupID(data.PrimaryUsergroup.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("primary_usergroup"), "Error Retrieving User Group", err.Error())
+		return nil
+	}
+	data.PrimaryUsergroupID = types.StringValue(primaryID)
+
+	var usergroups, subjects []string
+	diags.Append(data.BarrieredFromUsergroups.ElementsAs(ctx, &usergroups, false)...)
+	diags.Append(data.RestrictedSubjects.ElementsAs(ctx, &subjects, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	barrieredIDs := make([]string, 0, len(usergroups))
+	for _, usergroup := range usergroups {
+		id, err := r.client.ResolveUserGroupID(usergroup)
+		if err != nil {
+			diags.AddAttributeError(path.Root("barriered_from_usergroups"), "Error Retrieving User Group", err.Error())
+			return nil
+		}
+		barrieredIDs = append(barrieredIDs, id)
+	}
+	slices.Sort(barrieredIDs)
+	barrieredIDs = slices.Compact(barrieredIDs)
+
+	var d diag.Diagnostics
+	data.BarrieredFromUsergroupIDs, d = types.SetValueFrom(ctx, types.StringType, barrieredIDs)
+	diags.Append(d...)
+
+	slices.Sort(subjects)
+	return url.Values{
+		"primary_usergroup_id":         {primaryID},
+		"barriered_from_usergroup_ids": {strings.Join(barrieredIDs, ",")},
+		"restricted_subjects":          {strings.Join(subjects, ",")},
+	}
+}
+
+// findBarrier pages through admin.barriers.list for the barrier with the ID, returning nil
+// when there is none.
+func (r *resourceSlackInformationBarrier) findBarrier(ctx context.Context, id string) (*informationBarrier, error) {
+	cursor := ""
+	for {
+		var result struct {
+			Barriers         []informationBarrier `json:"barriers"`
+			ResponseMetadata struct {
+				NextCursor string `json:"next_cursor"`
+			} `json:"response_metadata"`
+		}
+		values := url.Values{"limit": {"100"}}
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+		if err := r.client.Call(ctx, "admin.barriers.list", values, &result); err != nil {
+			return nil, err
+		}
+
+		for i := range result.Barriers {
+			if result.Barriers[i].ID == id {
+				return &result.Barriers[i], nil
+			}
+		}
+
+		cursor = result.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return nil, nil
+		}
+	}
+}
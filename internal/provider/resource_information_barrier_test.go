@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_information_barrier(t *testing.T) {
+	// local stand-in keeping the information barriers in memory
+	var mu sync.Mutex
+	barriers := map[string]map[string]any{}
+
+	write := func(r *http.Request, id string) map[string]any {
+		barriered := []map[string]string{}
+		for _, usergroupID := range strings.Split(r.Form.Get("barriered_from_usergroup_ids"), ",") {
+			barriered = append(barriered, map[string]string{"id": usergroupID})
+		}
+		barrier := map[string]any{
+			"id":                        id,
+			"primary_usergroup":         map[string]string{"id": r.Form.Get("primary_usergroup_id")},
+			"barriered_from_usergroups": barriered,
+			"restricted_subjects":       strings.Split(r.Form.Get("restricted_subjects"), ","),
+		}
+		barriers[id] = barrier
+		return barrier
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			// the members are listed to catch a users.info lookup per member, which resolving an ID never needs
+			"usergroups.list": func(r *http.Request) string {
+				return `{"ok": true, "usergroups": [
+				{"id": "S0LEGAL", "name": "Legal", "handle": "legal", "users": ["U0ALICE", "U0BOB"]},
+				{"id": "S0SALES", "name": "Sales", "handle": "sales"},
+				{"id": "S0RESEARCH", "name": "Research", "handle": "research"}
+			]}`
+			},
+			"admin.barriers.create": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				body, _ := json.Marshal(map[string]any{"ok": true, "barrier": write(r, fmt.Sprintf("B%04d", len(barriers)+1))})
+				return string(body)
+			},
+			"admin.barriers.update": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if _, ok := barriers[r.Form.Get("barrier_id")]; !ok {
+					return `{"ok": false, "error": "barrier_not_found"}`
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "barrier": write(r, r.Form.Get("barrier_id"))})
+				return string(body)
+			},
+			"admin.barriers.delete": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				delete(barriers, r.Form.Get("barrier_id"))
+				return `{"ok": true}`
+			},
+			"admin.barriers.list": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				list := []map[string]any{}
+				for _, barrier := range barriers {
+					list = append(list, barrier)
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "barriers": list})
+				return string(body)
+			},
+		},
+		checkDestroy: testCheckStandIn(&mu, func() error {
+			if len(barriers) > 0 {
+				return fmt.Errorf("expected no information barriers, got %v", barriers)
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config:      testSlackInformationBarrierConfig(`"S0SALES"`, `"dm"`),
+				ExpectError: regexp.MustCompile("Invalid Restricted Subject"),
+			},
+			{
+				Config: testSlackInformationBarrierConfig(`"S0SALES"`, `"im", "mpim", "call"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_information_barrier.test", "id", "B0001"),
+					resource.TestCheckResourceAttr("slack_information_barrier.test", "primary_usergroup_id", "S0LEGAL"),
+					resource.TestCheckTypeSetElemAttr("slack_information_barrier.test", "barriered_from_usergroup_ids.*", "S0SALES"),
+					resource.TestCheckResourceAttr("slack_information_barrier.test", "restricted_subjects.#", "3"),
+				),
+			},
+			{
+				Config: testSlackInformationBarrierConfig(`"S0SALES", "@research"`, `"im", "mpim", "call"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_information_barrier.test", "id", "B0001"),
+					resource.TestCheckResourceAttr("slack_information_barrier.test", "barriered_from_usergroup_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("slack_information_barrier.test", "barriered_from_usergroup_ids.*", "S0RESEARCH"),
+				),
+			},
+			{
+				ResourceName:            "slack_information_barrier.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"primary_usergroup", "barriered_from_usergroups"},
+			},
+		},
+	})
+}
+
+func testSlackInformationBarrierConfig(barrieredFrom string, subjects string) string {
+	return fmt.Sprintf(`
+        resource "slack_information_barrier" "test" {
+            primary_usergroup         = "legal"
+            barriered_from_usergroups = [%s]
+            restricted_subjects       = [%s]
+        }
+    `, barrieredFrom, subjects)
+}
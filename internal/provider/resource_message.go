@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"terraform-provider-slack/internal/slackutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackMessage)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackMessage)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackMessage)(nil)
+)
+
+// messageConversationTypes are the conversation types a message channel name is looked up in.
+var messageConversationTypes = []string{"public_channel", "private_channel"}
+
+type Message struct {
+	Blocks      types.String `tfsdk:"blocks"`
+	Channel     types.String `tfsdk:"channel"`
+	ChannelID   types.String `tfsdk:"channel_id"`
+	ID          types.String `tfsdk:"id"`
+	Pin         types.Bool   `tfsdk:"pin"`
+	Text        types.String `tfsdk:"text"`
+	ThreadTS    types.String `tfsdk:"thread_ts"`
+	TS          types.String `tfsdk:"ts"`
+	UnfurlLinks types.Bool   `tfsdk:"unfurl_links"`
+	UnfurlMedia types.Bool   `tfsdk:"unfurl_media"`
+}
+
+type resourceSlackMessage struct {
+	client *slackClient
+}
+
+func NewResourceSlackMessage() resource.Resource {
+	return &resourceSlackMessage{}
+}
+
+func (r *resourceSlackMessage) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackMessage) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_message"
+}
+
+func (r *resourceSlackMessage) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data Message
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMessageContent(data.Text, data.Blocks, &resp.Diagnostics)
+}
+
+func (r *resourceSlackMessage) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_message", &resp.Diagnostics) {
+		return
+	}
+
+	var data Message
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID, err := r.client.ResolveConversationID(data.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Channel Retrieval Error on Create",
+			fmt.Sprintf("Failed to retrieve conversation ID: %v", err),
+		)
+		return
+	}
+
+	values := messageValues(channelID, data)
+	if !data.ThreadTS.IsNull() {
+		values.Set("thread_ts", data.ThreadTS.ValueString())
+	}
+	if !data.UnfurlLinks.IsNull() {
+		values.Set("unfurl_links", strconv.FormatBool(data.UnfurlLinks.ValueBool()))
+	}
+	if !data.UnfurlMedia.IsNull() {
+		values.Set("unfurl_media", strconv.FormatBool(data.UnfurlMedia.ValueBool()))
+	}
+
+	var posted struct {
+		Channel string `json:"channel"`
+		TS      string `json:"ts"`
+	}
+	if err := r.client.Call(ctx, "chat.postMessage", values, &posted); err != nil {
+		resp.Diagnostics.AddError("Error posting Slack message", err.Error())
+		return
+	}
+
+	data.ChannelID = types.StringValue(posted.Channel)
+	data.TS = types.StringValue(posted.TS)
+	data.ID = types.StringValue(posted.Channel + "/" + posted.TS)
+
+	if data.Pin.ValueBool() {
+		if err := r.client.AddPin(posted.Channel, slack.NewRefToMessage(posted.Channel, posted.TS)); err != nil && !isSlackError(err, "already_pinned") {
+			resp.Diagnostics.AddError("Error pinning Slack message", err.Error())
+			// save the posted message so it is not orphaned
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Posted Slack message", map[string]interface{}{
+		"channel_id": posted.Channel,
+		"ts":         posted.TS,
+	})
+}
+
+func (r *resourceSlackMessage) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_message", &resp.Diagnostics) {
+		return
+	}
+
+	var data Message
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.DeleteMessage(data.ChannelID.ValueString(), data.TS.ValueString())
+	if err != nil {
+		if isSlackError(err, "message_not_found", "channel_not_found") {
+			tflog.Warn(ctx, "Slack message not found, assuming it was already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Slack message", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Slack message", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackMessage) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	channelID, ts, ok := strings.Cut(req.ID, "/")
+	if !ok || channelID == "" || ts == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form `channel_id/ts`, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel"), channelID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ts"), ts)...)
+}
+
+func (r *resourceSlackMessage) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data Message
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := r.getMessage(data.ChannelID.ValueString(), data.TS.ValueString(), data.ThreadTS.ValueString())
+	if err != nil && !isSlackError(err, "channel_not_found", "thread_not_found") {
+		resp.Diagnostics.AddError("Error retrieving Slack message", err.Error())
+		return
+	}
+
+	if message == nil {
+		tflog.Warn(ctx, "Slack message not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	imported := data.Text.IsNull() && data.Blocks.IsNull()
+	text := html.UnescapeString(message.Text)
+
+	// Slack escapes &, < and > in message text, so compare both forms
+	if imported || (!data.Text.IsNull() && message.Text != data.Text.ValueString() && text != data.Text.ValueString()) {
+		data.Text = types.StringValue(text)
+	}
+
+	if !data.Blocks.IsNull() {
+		blocks, err := json.Marshal(message.Blocks)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding Slack message blocks", err.Error())
+			return
+		}
+
+		// Slack adds fields such as block_id to the blocks it returns
+		unchanged, err := slackutil.IsJSONSubset(data.Blocks.ValueString(), string(blocks))
+		if err != nil {
+			resp.Diagnostics.AddError("Error comparing Slack message blocks", err.Error())
+			return
+		}
+		if !unchanged {
+			data.Blocks = types.StringValue(string(blocks))
+		}
+	}
+
+	data.Pin = types.BoolValue(slices.Contains(message.PinnedTo, data.ChannelID.ValueString()))
+	if imported && message.ThreadTimestamp != "" && message.ThreadTimestamp != message.Timestamp {
+		data.ThreadTS = types.StringValue(message.ThreadTimestamp)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack message", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackMessage) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_message** resource manages a message posted to a Slack channel, such as a pinned "how to get help" announcement.
+
+Changes to ` + "`text`" + ` or ` + "`blocks`" + ` update the message in place, and destroying the resource deletes the message. Edits made in Slack and deleted messages are detected on refresh.
+
+Import is supported using ` + "`channel_id/ts`" + `.
+
+**Required scopes**
+
+Bot tokens: chat:write, channels:read, groups:read, channels:history, groups:history, pins:write
+`,
+		Attributes: map[string]schema.Attribute{
+			"blocks": schema.StringAttribute{
+				MarkdownDescription: "The Block Kit blocks of the message, as a JSON array (e.g. using `jsonencode`).",
+				Optional:            true,
+			},
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the channel to post the message to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessResolvesTo(&r.client, path.Root("channel_id"), (*slackClient).ResolveConversationID),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel the message was posted to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the message, in the form `channel_id/ts`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pin": schema.BoolAttribute{
+				MarkdownDescription: "Whether to pin the message to the channel. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text of the message. When `blocks` are set, it is used as the fallback text for notifications.",
+				Optional:            true,
+			},
+			"thread_ts": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the parent message to post the message as a thread reply.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ts": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the message.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unfurl_links": schema.BoolAttribute{
+				MarkdownDescription: "Whether to unfurl text-based content. Only applies when the message is posted.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"unfurl_media": schema.BoolAttribute{
+				MarkdownDescription: "Whether to unfurl media content. Only applies when the message is posted.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackMessage) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_message", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state Message
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := state.ChannelID.ValueString()
+	ts := state.TS.ValueString()
+
+	if !data.Text.Equal(state.Text) || !data.Blocks.Equal(state.Blocks) {
+		values := messageValues(channelID, data)
+		values.Set("ts", ts)
+
+		// chat.update keeps the previous text and blocks unless they are sent
+		if data.Text.IsNull() {
+			values.Set("text", "")
+		}
+		if data.Blocks.IsNull() {
+			values.Set("blocks", "[]")
+		}
+
+		if err := r.client.Call(ctx, "chat.update", values, nil); err != nil {
+			resp.Diagnostics.AddError("Error updating Slack message", err.Error())
+			return
+		}
+	}
+
+	if !data.Pin.Equal(state.Pin) {
+		item := slack.NewRefToMessage(channelID, ts)
+		if data.Pin.ValueBool() {
+			if err := r.client.AddPin(channelID, item); err != nil && !isSlackError(err, "already_pinned") {
+				resp.Diagnostics.AddError("Error pinning Slack message", err.Error())
+				return
+			}
+		} else {
+			if err := r.client.RemovePin(channelID, item); err != nil && !isSlackError(err, "no_pin") {
+				resp.Diagnostics.AddError("Error unpinning Slack message", err.Error())
+				return
+			}
+		}
+	}
+
+	data.ChannelID = state.ChannelID
+	data.ID = state.ID
+	data.TS = state.TS
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack message", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// getMessage returns a message by its timestamp, looking in the thread when threadTS is set,
+// or nil when the message no longer exists.
+func (r *resourceSlackMessage) getMessage(channelID string, ts string, threadTS string) (*slack.Message, error) {
+	var messages []slack.Message
+
+	if threadTS == "" || threadTS == ts {
+		history, err := r.client.GetConversationHistory(&slack.GetConversationHistoryParameters{
+			ChannelID: channelID,
+			Inclusive: true,
+			Latest:    ts,
+			Oldest:    ts,
+			Limit:     1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = history.Messages
+	} else {
+		replies, _, _, err := r.client.GetConversationReplies(&slack.GetConversationRepliesParameters{
+			ChannelID: channelID,
+			Timestamp: threadTS,
+			Inclusive: true,
+			Latest:    ts,
+			Oldest:    ts,
+			Limit:     1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = replies
+	}
+
+	for _, message := range messages {
+		if message.Timestamp == ts && message.SubType != "tombstone" {
+			messageCopy := message
+			return &messageCopy, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// messageValues returns the chat.postMessage and chat.update parameters for the message content.
+func messageValues(channelID string, data Message) url.Values {
+	values := url.Values{
+		"channel": {channelID},
+	}
+	if !data.Text.IsNull() {
+		values.Set("text", data.Text.ValueString())
+	}
+	if !data.Blocks.IsNull() {
+		values.Set("blocks", data.Blocks.ValueString())
+	}
+	return values
+}
+
+// validateMessageContent checks that a message has text or blocks, and that blocks is a JSON array.
+func validateMessageContent(text types.String, blocks types.String, diags *diag.Diagnostics) {
+	if text.IsNull() && blocks.IsNull() {
+		diags.AddError(
+			"Missing Message Content",
+			"At least one of `text` or `blocks` must be set.",
+		)
+		return
+	}
+
+	if !blocks.IsNull() && !blocks.IsUnknown() {
+		var parsed []json.RawMessage
+		if err := json.Unmarshal([]byte(blocks.ValueString()), &parsed); err != nil {
+			diags.AddAttributeError(
+				path.Root("blocks"),
+				"Invalid Block Kit JSON",
+				fmt.Sprintf("The blocks attribute must be a JSON array of Block Kit blocks: %s", err.Error()),
+			)
+		}
+	}
+}
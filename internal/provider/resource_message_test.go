@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_message(t *testing.T) {
+	// local stand-in keeping the posted message in memory
+	var mu sync.Mutex
+	message := map[string]any{}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0GENERAL", "name": "general", "is_channel": true}], "response_metadata": {"next_cursor": ""}}`
+			},
+			"chat.postMessage": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				message = map[string]any{"type": "message", "ts": "1700000000.000100", "text": r.Form.Get("text")}
+				return fmt.Sprintf(`{"ok": true, "channel": "%s", "ts": "1700000000.000100"}`, r.Form.Get("channel"))
+			},
+			"chat.update": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				message["text"] = r.Form.Get("text")
+				return `{"ok": true, "channel": "C0GENERAL", "ts": "1700000000.000100"}`
+			},
+			"chat.delete": func(r *http.Request) string {
+				return `{"ok": true, "channel": "C0GENERAL", "ts": "1700000000.000100"}`
+			},
+			"pins.add": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				message["pinned_to"] = []string{r.Form.Get("channel")}
+				return `{"ok": true}`
+			},
+			"conversations.history": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				body, _ := json.Marshal(map[string]any{"ok": true, "messages": []any{message}})
+				return string(body)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: testSlackMessageConfig("How to get help: ask in #help"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_message.test", "channel_id", "C0GENERAL"),
+					resource.TestCheckResourceAttr("slack_message.test", "id", "C0GENERAL/1700000000.000100"),
+					resource.TestCheckResourceAttr("slack_message.test", "pin", "true"),
+				),
+			},
+			{
+				Config: testSlackMessageConfig("How to get help: ask in #support"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_message.test", "text", "How to get help: ask in #support"),
+					resource.TestCheckResourceAttr("slack_message.test", "ts", "1700000000.000100"),
+				),
+			},
+			{
+				ResourceName:            "slack_message.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"channel"},
+			},
+		},
+	})
+}
+
+func testSlackMessageConfig(text string) string {
+	return fmt.Sprintf(`
+        resource "slack_message" "test" {
+            channel = "general"
+            text    = "%s"
+            pin     = true
+        }
+    `, text)
+}
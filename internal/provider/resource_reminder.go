@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackReminder)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackReminder)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackReminder)(nil)
+)
+
+// reminderFrequencies are the recurrence frequencies reminders.add accepts.
+var reminderFrequencies = []string{"daily", "weekly", "monthly", "yearly"}
+
+// reminderWeekdays are the days a weekly reminder can recur on.
+var reminderWeekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+type Reminder struct {
+	CompleteTS         types.Int64  `tfsdk:"complete_ts"`
+	Creator            types.String `tfsdk:"creator"`
+	ID                 types.String `tfsdk:"id"`
+	Recurrence         types.String `tfsdk:"recurrence"`
+	RecurrenceWeekdays types.Set    `tfsdk:"recurrence_weekdays"`
+	Recurring          types.Bool   `tfsdk:"recurring"`
+	Text               types.String `tfsdk:"text"`
+	Time               types.String `tfsdk:"time"`
+	User               types.String `tfsdk:"user"`
+}
+
+// slackReminder is a reminder as returned by the reminders.* methods.
+type slackReminder struct {
+	CompleteTS int64  `json:"complete_ts"`
+	Creator    string `json:"creator"`
+	ID         string `json:"id"`
+	Recurrence *struct {
+		Frequency string   `json:"frequency"`
+		Weekdays  []string `json:"weekdays"`
+	} `json:"recurrence"`
+	Recurring bool   `json:"recurring"`
+	Text      string `json:"text"`
+	Time      int64  `json:"time"`
+	User      string `json:"user"`
+}
+
+type resourceSlackReminder struct {
+	client *slackClient
+}
+
+func NewResourceSlackReminder() resource.Resource {
+	return &resourceSlackReminder{}
+}
+
+func (r *resourceSlackReminder) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackReminder) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_reminder"
+}
+
+func (r *resourceSlackReminder) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data Reminder
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Text.IsNull() && !data.Text.IsUnknown() && strings.TrimSpace(data.Text.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("text"), "Invalid Reminder Text", "The reminder text must not be empty.")
+	}
+
+	if !data.Recurrence.IsNull() && !data.Recurrence.IsUnknown() && !slices.Contains(reminderFrequencies, data.Recurrence.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recurrence"),
+			"Invalid Recurrence",
+			fmt.Sprintf("The recurrence must be one of %v, got %q.", reminderFrequencies, data.Recurrence.ValueString()),
+		)
+	}
+
+	if data.RecurrenceWeekdays.IsNull() || data.RecurrenceWeekdays.IsUnknown() {
+		return
+	}
+
+	if data.Recurrence.ValueString() != "weekly" && !data.Recurrence.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recurrence_weekdays"),
+			"Invalid Recurrence Weekdays",
+			"The recurrence weekdays can only be set when the recurrence is `weekly`.",
+		)
+		return
+	}
+
+	var weekdays []string
+	resp.Diagnostics.Append(data.RecurrenceWeekdays.ElementsAs(ctx, &weekdays, false)...)
+	for _, weekday := range weekdays {
+		if !slices.Contains(reminderWeekdays, weekday) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("recurrence_weekdays"),
+				"Invalid Recurrence Weekdays",
+				fmt.Sprintf("The recurrence weekdays must be any of %v, got %q.", reminderWeekdays, weekday),
+			)
+		}
+	}
+}
+
+func (r *resourceSlackReminder) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_reminder", &resp.Diagnostics) {
+		return
+	}
+
+	var data Reminder
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := url.Values{
+		"text": {data.Text.ValueString()},
+		"time": {reminderTime(data.Time.ValueString())},
+	}
+	if !data.User.IsNull() && !data.User.IsUnknown() {
+		values.Set("user", data.User.ValueString())
+	}
+	if !data.Recurrence.IsNull() {
+		recurrence := map[string]any{"frequency": data.Recurrence.ValueString()}
+		if !data.RecurrenceWeekdays.IsNull() {
+			var weekdays []string
+			resp.Diagnostics.Append(data.RecurrenceWeekdays.ElementsAs(ctx, &weekdays, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			slices.Sort(weekdays)
+			recurrence["weekdays"] = weekdays
+		}
+		encoded, err := json.Marshal(recurrence)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encoding Slack reminder recurrence", err.Error())
+			return
+		}
+		values.Set("recurrence", string(encoded))
+	}
+
+	var result struct {
+		Reminder slackReminder `json:"reminder"`
+	}
+	if err := r.client.Call(ctx, "reminders.add", values, &result); err != nil {
+		resp.Diagnostics.AddError("Error creating Slack reminder", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(result.Reminder.ID)
+	setReminderState(&data, &result.Reminder)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Created Slack reminder", map[string]interface{}{
+		"id":   data.ID.ValueString(),
+		"user": data.User.ValueString(),
+	})
+}
+
+func (r *resourceSlackReminder) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_reminder", &resp.Diagnostics) {
+		return
+	}
+
+	var data Reminder
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteReminderContext(ctx, data.ID.ValueString()); err != nil {
+		if isSlackError(err, "not_found") {
+			tflog.Warn(ctx, "Slack reminder not found, assuming it was already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Slack reminder", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Slack reminder", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState looks the reminder up with reminders.info, as the time it was created with is
+// only known to Slack as a timestamp.
+func (r *resourceSlackReminder) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var result struct {
+		Reminder slackReminder `json:"reminder"`
+	}
+	if err := r.client.Call(ctx, "reminders.info", url.Values{"reminder": {req.ID}}, &result); err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack reminder", err.Error())
+		return
+	}
+
+	data := Reminder{
+		ID:                 types.StringValue(result.Reminder.ID),
+		Recurrence:         types.StringNull(),
+		RecurrenceWeekdays: types.SetNull(types.StringType),
+		Time:               types.StringValue(time.Unix(result.Reminder.Time, 0).UTC().Format(time.RFC3339)),
+	}
+	setReminderState(&data, &result.Reminder)
+	resp.Diagnostics.Append(setReminderRecurrence(ctx, &data, &result.Reminder)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackReminder) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data Reminder
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Reminders []slackReminder `json:"reminders"`
+	}
+	if err := r.client.Call(ctx, "reminders.list", url.Values{}, &result); err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack reminders", err.Error())
+		return
+	}
+
+	index := slices.IndexFunc(result.Reminders, func(reminder slackReminder) bool {
+		return reminder.ID == data.ID.ValueString()
+	})
+	if index == -1 {
+		tflog.Warn(ctx, "Slack reminder not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setReminderState(&data, &result.Reminders[index])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackReminder) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_reminder** resource manages a Slack reminder for a user, such as a weekly reminder to submit timesheets.
+
+Reminders cannot be edited in Slack, so any change replaces the reminder. A reminder deleted in Slack is created again on the next apply, and a change to its text or user in Slack is planned as a replacement.
+
+Import is supported using the reminder ID. The ` + "`time`" + ` is imported as the RFC3339 time of the next reminder.
+
+**Required scopes**
+
+User tokens: reminders:read, reminders:write
+`,
+		Attributes: map[string]schema.Attribute{
+			"complete_ts": schema.Int64Attribute{
+				MarkdownDescription: "The timestamp (epoch) when a one-off reminder was marked as complete, or `0` when it is not complete.",
+				Computed:            true,
+			},
+			"creator": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user who created the reminder.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the reminder.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recurrence": schema.StringAttribute{
+				MarkdownDescription: "How often the reminder repeats, one of `daily`, `weekly`, `monthly` or `yearly`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recurrence_weekdays": schema.SetAttribute{
+				MarkdownDescription: "The days a `weekly` reminder repeats on, such as `friday`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"recurring": schema.BoolAttribute{
+				MarkdownDescription: "Whether the reminder repeats.",
+				Computed:            true,
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The content of the reminder.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "When the reminder fires, either an RFC3339 time such as `2030-01-04T16:00:00Z` or a natural-language description such as `every Friday at 4pm`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the reminder is for. Defaults to the user the API token belongs to.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Update only records changes to the computed attributes, as every configurable attribute
+// requires a replacement.
+func (r *resourceSlackReminder) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_reminder", &resp.Diagnostics) {
+		return
+	}
+
+	var data Reminder
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setReminderState copies the attributes Slack reports for a reminder into the model.
+func setReminderState(data *Reminder, reminder *slackReminder) {
+	data.CompleteTS = types.Int64Value(reminder.CompleteTS)
+	data.Creator = types.StringValue(reminder.Creator)
+	data.Recurring = types.BoolValue(reminder.Recurring)
+	data.Text = types.StringValue(reminder.Text)
+	data.User = types.StringValue(reminder.User)
+}
+
+// setReminderRecurrence copies the recurrence of an imported reminder into the model.
+func setReminderRecurrence(ctx context.Context, data *Reminder, reminder *slackReminder) diag.Diagnostics {
+	if reminder.Recurrence == nil || reminder.Recurrence.Frequency == "" {
+		return nil
+	}
+
+	data.Recurrence = types.StringValue(reminder.Recurrence.Frequency)
+	if len(reminder.Recurrence.Weekdays) == 0 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	data.RecurrenceWeekdays, diags = types.SetValueFrom(ctx, types.StringType, reminder.Recurrence.Weekdays)
+	return diags
+}
+
+// reminderTime converts an RFC3339 time to the Unix timestamp reminders.add expects, passing
+// natural-language times through unchanged.
+func reminderTime(value string) string {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+	return value
+}
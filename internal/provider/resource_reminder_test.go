@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_reminder(t *testing.T) {
+	// local stand-in keeping the reminders in memory
+	var mu sync.Mutex
+	reminders := map[string]map[string]any{}
+	count := 0
+
+	encode := func(v any) string {
+		body, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	// RFC3339 times must arrive as timestamps
+	checkSent := func(id string, field string, want any) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			reminder, ok := reminders[id]
+			if !ok {
+				return fmt.Errorf("reminder %s not found", id)
+			}
+			if got := fmt.Sprint(reminder[field]); got != fmt.Sprint(want) {
+				return fmt.Errorf("expected %s to be %v, got %s", field, want, got)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"reminders.add": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				count++
+				id := fmt.Sprintf("Rm%04d", count)
+				user := r.Form.Get("user")
+				if user == "" {
+					user = "U0BOT"
+				}
+				time, _ := strconv.ParseInt(r.Form.Get("time"), 10, 64)
+				reminder := map[string]any{
+					"id":          id,
+					"creator":     "U0BOT",
+					"user":        user,
+					"text":        r.Form.Get("text"),
+					"time":        time,
+					"recurring":   r.Form.Get("recurrence") != "",
+					"complete_ts": 0,
+				}
+				if recurrence := r.Form.Get("recurrence"); recurrence != "" {
+					reminder["recurrence"] = json.RawMessage(recurrence)
+				}
+				reminders[id] = reminder
+				return encode(map[string]any{"ok": true, "reminder": reminder})
+			},
+			"reminders.info": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				reminder, ok := reminders[r.Form.Get("reminder")]
+				if !ok {
+					return `{"ok": false, "error": "not_found"}`
+				}
+				return encode(map[string]any{"ok": true, "reminder": reminder})
+			},
+			"reminders.list": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				list := []map[string]any{}
+				for _, reminder := range reminders {
+					list = append(list, reminder)
+				}
+				return encode(map[string]any{"ok": true, "reminders": list})
+			},
+			"reminders.delete": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if _, ok := reminders[r.Form.Get("reminder")]; !ok {
+					return `{"ok": false, "error": "not_found"}`
+				}
+				delete(reminders, r.Form.Get("reminder"))
+				return `{"ok": true}`
+			},
+		},
+		checkDestroy: testCheckStandIn(&mu, func() error {
+			if len(reminders) != 0 {
+				return fmt.Errorf("expected all reminders to be deleted, got %d", len(reminders))
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config: testSlackReminderConfig("Submit your timesheets", `
+                    recurrence          = "daily"
+                    recurrence_weekdays = ["friday"]
+                `),
+				ExpectError: regexp.MustCompile(`Invalid Recurrence Weekdays`),
+			},
+			{
+				Config: testSlackReminderConfig("Submit your timesheets", `
+                    recurrence          = "weekly"
+                    recurrence_weekdays = ["friday"]
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_reminder.test", "id", "Rm0001"),
+					resource.TestCheckResourceAttr("slack_reminder.test", "user", "U0BOT"),
+					resource.TestCheckResourceAttr("slack_reminder.test", "creator", "U0BOT"),
+					resource.TestCheckResourceAttr("slack_reminder.test", "recurring", "true"),
+					checkSent("Rm0001", "time", "1893772800"),
+				),
+			},
+			{
+				ResourceName:            "slack_reminder.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"time"},
+			},
+			{
+				// a reminder deleted in Slack is created again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					delete(reminders, "Rm0001")
+				},
+				Config: testSlackReminderConfig("Submit your timesheets", `
+                    recurrence          = "weekly"
+                    recurrence_weekdays = ["friday"]
+                `),
+				Check: resource.TestCheckResourceAttr("slack_reminder.test", "id", "Rm0002"),
+			},
+			{
+				// a change to the text replaces the reminder
+				Config: testSlackReminderConfig("Submit your expenses", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_reminder.test", "id", "Rm0003"),
+					resource.TestCheckResourceAttr("slack_reminder.test", "recurring", "false"),
+					checkSent("Rm0003", "text", "Submit your expenses"),
+				),
+			},
+		},
+	})
+}
+
+func testSlackReminderConfig(text string, extra string) string {
+	return fmt.Sprintf(`
+        resource "slack_reminder" "test" {
+            text = "%s"
+            time = "2030-01-04T16:00:00Z"
+            %s
+        }
+    `, text, extra)
+}
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackScheduledMessage)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackScheduledMessage)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackScheduledMessage)(nil)
+)
+
+const (
+	scheduledMessageStatusScheduled = "scheduled"
+	scheduledMessageStatusSent      = "sent"
+)
+
+type ScheduledMessage struct {
+	Blocks    types.String `tfsdk:"blocks"`
+	Channel   types.String `tfsdk:"channel"`
+	ChannelID types.String `tfsdk:"channel_id"`
+	ID        types.String `tfsdk:"id"`
+	PostAt    types.String `tfsdk:"post_at"`
+	Status    types.String `tfsdk:"status"`
+	Text      types.String `tfsdk:"text"`
+	ThreadTS  types.String `tfsdk:"thread_ts"`
+}
+
+type resourceSlackScheduledMessage struct {
+	client *slackClient
+}
+
+func NewResourceSlackScheduledMessage() resource.Resource {
+	return &resourceSlackScheduledMessage{}
+}
+
+func (r *resourceSlackScheduledMessage) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackScheduledMessage) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_scheduled_message"
+}
+
+func (r *resourceSlackScheduledMessage) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ScheduledMessage
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMessageContent(data.Text, data.Blocks, &resp.Diagnostics)
+
+	// the time is only checked against the clock on create, as a sent message keeps its past post_at
+	if !data.PostAt.IsNull() && !data.PostAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.PostAt.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("post_at"),
+				"Invalid post_at Time",
+				fmt.Sprintf("The post_at attribute must be an RFC3339 timestamp such as 2025-01-31T22:00:00Z: %s", err.Error()),
+			)
+		}
+	}
+}
+
+func (r *resourceSlackScheduledMessage) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_scheduled_message", &resp.Diagnostics) {
+		return
+	}
+
+	var data ScheduledMessage
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	postAt, err := time.Parse(time.RFC3339, data.PostAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid post_at Time", err.Error())
+		return
+	}
+
+	if !postAt.After(time.Now()) {
+		resp.Diagnostics.AddError(
+			"Scheduled Time In The Past",
+			fmt.Sprintf("The post_at time %s has already passed. Slack only schedules messages for a future time.", data.PostAt.ValueString()),
+		)
+		return
+	}
+
+	channelID, err := r.client.ResolveConversationID(data.Channel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Channel Retrieval Error on Create",
+			fmt.Sprintf("Failed to retrieve conversation ID: %v", err),
+		)
+		return
+	}
+
+	values := messageValues(channelID, Message{Text: data.Text, Blocks: data.Blocks})
+	values.Set("post_at", strconv.FormatInt(postAt.Unix(), 10))
+	if !data.ThreadTS.IsNull() {
+		values.Set("thread_ts", data.ThreadTS.ValueString())
+	}
+
+	var scheduled struct {
+		Channel            string `json:"channel"`
+		ScheduledMessageID string `json:"scheduled_message_id"`
+	}
+	if err := r.client.Call(ctx, "chat.scheduleMessage", values, &scheduled); err != nil {
+		resp.Diagnostics.AddError("Error scheduling Slack message", err.Error())
+		return
+	}
+
+	data.ChannelID = types.StringValue(scheduled.Channel)
+	data.ID = types.StringValue(scheduled.ScheduledMessageID)
+	data.Status = types.StringValue(scheduledMessageStatusScheduled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Scheduled Slack message", map[string]interface{}{
+		"channel_id": scheduled.Channel,
+		"id":         scheduled.ScheduledMessageID,
+		"post_at":    postAt.Unix(),
+	})
+}
+
+func (r *resourceSlackScheduledMessage) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_scheduled_message", &resp.Diagnostics) {
+		return
+	}
+
+	var data ScheduledMessage
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a sent message is an ordinary message now, so there is nothing left to cancel
+	if data.Status.ValueString() == scheduledMessageStatusSent {
+		tflog.Trace(ctx, "Slack scheduled message was already sent, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		return
+	}
+
+	_, err := r.client.DeleteScheduledMessage(&slack.DeleteScheduledMessageParameters{
+		Channel:            data.ChannelID.ValueString(),
+		ScheduledMessageID: data.ID.ValueString(),
+	})
+	if err != nil {
+		if isSlackError(err, "invalid_scheduled_message_id", "channel_not_found") {
+			tflog.Warn(ctx, "Slack scheduled message not found, assuming it was already sent or cancelled", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error cancelling Slack scheduled message", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Cancelled Slack scheduled message", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackScheduledMessage) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	channelID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || channelID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form `channel_id/scheduled_message_id`, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel"), channelID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+}
+
+func (r *resourceSlackScheduledMessage) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScheduledMessage
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := r.getScheduledMessage(ctx, data.ChannelID.ValueString(), data.ID.ValueString())
+	if err != nil && !isSlackError(err, "channel_not_found") {
+		resp.Diagnostics.AddError("Error retrieving Slack scheduled message", err.Error())
+		return
+	}
+
+	if message == nil {
+		// chat.scheduledMessages.list only returns pending messages, so a missing
+		// message was either sent at post_at or cancelled before it
+		postAt, err := time.Parse(time.RFC3339, data.PostAt.ValueString())
+		if err != nil || postAt.After(time.Now()) {
+			tflog.Warn(ctx, "Slack scheduled message was cancelled, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		data.Status = types.StringValue(scheduledMessageStatusSent)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// keep the configured time zone unless the time itself changed
+	postAt, err := time.Parse(time.RFC3339, data.PostAt.ValueString())
+	if err != nil || postAt.Unix() != int64(message.PostAt) {
+		data.PostAt = types.StringValue(time.Unix(int64(message.PostAt), 0).UTC().Format(time.RFC3339))
+	}
+
+	if data.Text.IsNull() && data.Blocks.IsNull() {
+		data.Text = types.StringValue(html.UnescapeString(message.Text))
+	}
+
+	data.Status = types.StringValue(scheduledMessageStatusScheduled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack scheduled message", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackScheduledMessage) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_scheduled_message** resource schedules a message to be posted to a Slack channel at a later time, such as a maintenance window announcement.
+
+Scheduled messages cannot be edited, so any change replaces the message, and destroying the resource cancels it. Once the message has been posted, ` + "`status`" + ` becomes ` + "`sent`" + ` and destroying the resource only removes it from the state. A message cancelled outside Terraform is scheduled again.
+
+Import is supported using ` + "`channel_id/scheduled_message_id`" + `.
+
+**Required scopes**
+
+Bot tokens: chat:write, channels:read, groups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"blocks": schema.StringAttribute{
+				MarkdownDescription: "The Block Kit blocks of the message, as a JSON array (e.g. using `jsonencode`).",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "The name or ID of the channel to post the message to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessResolvesTo(&r.client, path.Root("channel_id"), (*slackClient).ResolveConversationID),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel the message is scheduled in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the scheduled message.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"post_at": schema.StringAttribute{
+				MarkdownDescription: "The time to post the message at, as an RFC3339 timestamp (e.g. `2025-01-31T22:00:00+10:00`). Must be in the future when the message is scheduled.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the message, either `scheduled` or `sent`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text of the message. When `blocks` are set, it is used as the fallback text for notifications.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"thread_ts": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the parent message to post the message as a thread reply.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackScheduledMessage) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_scheduled_message", &resp.Diagnostics) {
+		return
+	}
+
+	// every configurable attribute requires replacement, so only the state needs updating
+	var data ScheduledMessage
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getScheduledMessage returns a pending scheduled message by its ID, or nil when it was sent or cancelled.
+func (r *resourceSlackScheduledMessage) getScheduledMessage(ctx context.Context, channelID string, id string) (*slack.ScheduledMessage, error) {
+	params := &slack.GetScheduledMessagesParameters{
+		Channel: channelID,
+		Limit:   100,
+	}
+
+	for {
+		messages, nextCursor, err := r.client.GetScheduledMessagesContext(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			if message.ID == id {
+				messageCopy := message
+				return &messageCopy, nil
+			}
+		}
+
+		if nextCursor == "" {
+			return nil, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_scheduled_message(t *testing.T) {
+	// local stand-in keeping the pending scheduled messages in memory
+	var mu sync.Mutex
+	scheduled := map[string]map[string]any{}
+	count := 0
+
+	postAt := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC().Format(time.RFC3339)
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0GENERAL", "name": "general", "is_channel": true}], "response_metadata": {"next_cursor": ""}}`
+			},
+			"chat.scheduleMessage": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				count++
+				id := fmt.Sprintf("Q0SCHEDULED%d", count)
+				postAt, _ := strconv.Atoi(r.Form.Get("post_at"))
+				scheduled[id] = map[string]any{"id": id, "channel_id": r.Form.Get("channel"), "post_at": postAt, "text": r.Form.Get("text")}
+				return fmt.Sprintf(`{"ok": true, "channel": "%s", "scheduled_message_id": "%s", "post_at": %d}`, r.Form.Get("channel"), id, postAt)
+			},
+			"chat.scheduledMessages.list": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				messages := []any{}
+				for _, message := range scheduled {
+					messages = append(messages, message)
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "scheduled_messages": messages, "response_metadata": map[string]any{"next_cursor": ""}})
+				return string(body)
+			},
+			"chat.deleteScheduledMessage": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if _, ok := scheduled[r.Form.Get("scheduled_message_id")]; !ok {
+					return `{"ok": false, "error": "invalid_scheduled_message_id"}`
+				}
+				delete(scheduled, r.Form.Get("scheduled_message_id"))
+				return `{"ok": true}`
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: testSlackScheduledMessageConfig(postAt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_scheduled_message.test", "channel_id", "C0GENERAL"),
+					resource.TestCheckResourceAttr("slack_scheduled_message.test", "id", "Q0SCHEDULED1"),
+					resource.TestCheckResourceAttr("slack_scheduled_message.test", "status", "scheduled"),
+				),
+			},
+			{
+				// cancelled in Slack, so it is scheduled again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					delete(scheduled, "Q0SCHEDULED1")
+				},
+				Config: testSlackScheduledMessageConfig(postAt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_scheduled_message.test", "id", "Q0SCHEDULED2"),
+					resource.TestCheckResourceAttr("slack_scheduled_message.test", "post_at", postAt),
+				),
+			},
+			{
+				ResourceName:            "slack_scheduled_message.test",
+				ImportState:             true,
+				ImportStateIdPrefix:     "C0GENERAL/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"channel"},
+			},
+		},
+	})
+}
+
+func testSlackScheduledMessageConfig(postAt string) string {
+	return fmt.Sprintf(`
+        resource "slack_scheduled_message" "test" {
+            channel = "general"
+            post_at = "%s"
+            text    = "Maintenance starts in one hour"
+        }
+    `, postAt)
+}
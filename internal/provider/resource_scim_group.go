@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*resourceSlackSCIMGroup)(nil)
+	_ resource.ResourceWithImportState = (*resourceSlackSCIMGroup)(nil)
+)
+
+type SCIMGroup struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	ID          types.String `tfsdk:"id"`
+	Members     types.Set    `tfsdk:"members"`
+}
+
+// scimGroup is a group of the SCIM API.
+type scimGroup struct {
+	DisplayName string      `json:"displayName"`
+	ID          string      `json:"id,omitempty"`
+	Members     []scimValue `json:"members,omitempty"`
+	Schemas     []string    `json:"schemas,omitempty"`
+}
+
+type resourceSlackSCIMGroup struct {
+	client *slackClient
+}
+
+func NewResourceSlackSCIMGroup() resource.Resource {
+	return &resourceSlackSCIMGroup{}
+}
+
+func (r *resourceSlackSCIMGroup) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackSCIMGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_scim_group"
+}
+
+func (r *resourceSlackSCIMGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_scim_group", &resp.Diagnostics) {
+		return
+	}
+
+	var data SCIMGroup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []string
+	if !data.Members.IsNull() {
+		resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	group := scimGroup{
+		DisplayName: data.DisplayName.ValueString(),
+		Members:     scimMembers(members),
+		Schemas:     []string{scimSchemaGroup},
+	}
+
+	var created scimGroup
+	if err := r.client.SCIM(ctx, http.MethodPost, "Groups", group, &created); err != nil {
+		resp.Diagnostics.AddError("Error creating Slack SCIM group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setSCIMGroupState(ctx, &data, &created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Created Slack SCIM group", map[string]interface{}{
+		"id":           data.ID.ValueString(),
+		"display_name": data.DisplayName.ValueString(),
+	})
+}
+
+func (r *resourceSlackSCIMGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_scim_group", &resp.Diagnostics) {
+		return
+	}
+
+	var data SCIMGroup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SCIM(ctx, http.MethodDelete, "Groups/"+data.ID.ValueString(), nil, nil); err != nil {
+		if isSCIMNotFound(err) {
+			tflog.Warn(ctx, "Slack SCIM group not found, assuming it was already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Slack SCIM group", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Slack SCIM group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackSCIMGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceSlackSCIMGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SCIMGroup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group scimGroup
+	if err := r.client.SCIM(ctx, http.MethodGet, "Groups/"+data.ID.ValueString(), nil, &group); err != nil {
+		if isSCIMNotFound(err) {
+			tflog.Warn(ctx, "Slack SCIM group not found, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack SCIM group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setSCIMGroupState(ctx, &data, &group)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackSCIMGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_scim_group** resource manages a group of an Enterprise organization and its members through the SCIM 2.0 API.
+
+The members are authoritative: members added outside Terraform are removed on the next apply. Changes are sent as SCIM ` + "`PATCH`" + ` requests that only add and remove the changed members. The provider ` + "`scim_url`" + ` and ` + "`scim_token`" + ` are used for every request.
+
+Import is supported using the SCIM group ID.
+
+**Required scopes**
+
+User tokens: admin
+`,
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The SCIM ID of the group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "The SCIM IDs of the users in the group, such as the `id` of a **slack_scim_user**.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackSCIMGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_scim_group", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state SCIMGroup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var operations []scimPatchOperation
+	if !data.DisplayName.Equal(state.DisplayName) {
+		operations = append(operations, scimPatchOperation{Op: "replace", Path: "displayName", Value: data.DisplayName.ValueString()})
+	}
+
+	var planned, current []string
+	if !data.Members.IsNull() {
+		resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &planned, false)...)
+	}
+	if !state.Members.IsNull() {
+		resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &current, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var added, removed []string
+	for _, member := range planned {
+		if !slices.Contains(current, member) {
+			added = append(added, member)
+		}
+	}
+	for _, member := range current {
+		if !slices.Contains(planned, member) {
+			removed = append(removed, member)
+		}
+	}
+	if len(added) > 0 {
+		operations = append(operations, scimPatchOperation{Op: "add", Path: "members", Value: scimMembers(added)})
+	}
+	if len(removed) > 0 {
+		operations = append(operations, scimPatchOperation{Op: "remove", Path: "members", Value: scimMembers(removed)})
+	}
+
+	if len(operations) > 0 {
+		if err := r.client.SCIM(ctx, http.MethodPatch, "Groups/"+state.ID.ValueString(), newSCIMPatch(operations), nil); err != nil {
+			resp.Diagnostics.AddError("Error updating Slack SCIM group", err.Error())
+			return
+		}
+	}
+
+	// a PATCH of a group may not return the group, so read it back
+	var group scimGroup
+	if err := r.client.SCIM(ctx, http.MethodGet, "Groups/"+state.ID.ValueString(), nil, &group); err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack SCIM group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setSCIMGroupState(ctx, &data, &group)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack SCIM group", map[string]interface{}{
+		"id":      data.ID.ValueString(),
+		"added":   len(added),
+		"removed": len(removed),
+	})
+}
+
+// setSCIMGroupState copies a SCIM group into the model, keeping the members null when they
+// are not set in the state and the group has none.
+func setSCIMGroupState(ctx context.Context, data *SCIMGroup, group *scimGroup) diag.Diagnostics {
+	data.ID = types.StringValue(group.ID)
+	data.DisplayName = types.StringValue(group.DisplayName)
+
+	if len(group.Members) == 0 && data.Members.IsNull() {
+		return nil
+	}
+
+	members := make([]string, 0, len(group.Members))
+	for _, member := range group.Members {
+		members = append(members, member.Value)
+	}
+
+	var diags diag.Diagnostics
+	data.Members, diags = types.SetValueFrom(ctx, types.StringType, members)
+	return diags
+}
+
+// scimMembers returns user IDs as SCIM group members.
+func scimMembers(ids []string) []scimValue {
+	values := make([]scimValue, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, scimValue{Value: id})
+	}
+	return values
+}
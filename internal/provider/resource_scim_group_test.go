@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_scim_group(t *testing.T) {
+	scim := newSCIMStandIn(t)
+
+	// the last PATCH must only carry the changed members
+	checkLastPatch := func(want string) resource.TestCheckFunc {
+		return testCheckStandIn(&scim.mu, func() error {
+			if len(scim.patches) == 0 {
+				return fmt.Errorf("expected a PATCH request")
+			}
+			if got := fmt.Sprint(scim.patches[len(scim.patches)-1]["Operations"]); got != want {
+				return fmt.Errorf("expected operations %s, got %s", want, got)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		providerConfig: fmt.Sprintf(`
+            scim_token = "xoxp-scim"
+            scim_url   = "%s/scim/v2/"
+        `, scim.URL),
+		checkDestroy: testCheckStandIn(&scim.mu, func() error {
+			if len(scim.resources["Groups"]) != 0 {
+				return fmt.Errorf("expected the group to be deleted")
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config: testSlackSCIMGroupConfig(`"W0100", "W0101"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_scim_group.test", "id", "W0001"),
+					resource.TestCheckResourceAttr("slack_scim_group.test", "members.#", "2"),
+				),
+			},
+			{
+				Config: testSlackSCIMGroupConfig(`"W0101", "W0102"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_scim_group.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemAttr("slack_scim_group.test", "members.*", "W0102"),
+					checkLastPatch("[map[op:add path:members value:[map[value:W0102]]] map[op:remove path:members value:[map[value:W0100]]]]"),
+				),
+			},
+			{
+				ResourceName:      "slack_scim_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testSlackSCIMGroupConfig(members string) string {
+	return fmt.Sprintf(`
+        resource "slack_scim_group" "test" {
+            display_name = "engineering"
+            members      = [%s]
+        }
+    `, members)
+}
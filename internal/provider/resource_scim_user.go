@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*resourceSlackSCIMUser)(nil)
+	_ resource.ResourceWithImportState = (*resourceSlackSCIMUser)(nil)
+)
+
+type SCIMUser struct {
+	Active      types.Bool   `tfsdk:"active"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Emails      types.List   `tfsdk:"emails"`
+	FamilyName  types.String `tfsdk:"family_name"`
+	GivenName   types.String `tfsdk:"given_name"`
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	UserName    types.String `tfsdk:"user_name"`
+}
+
+// scimUser is a user of the SCIM API.
+type scimUser struct {
+	Active      *bool       `json:"active,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []scimValue `json:"emails,omitempty"`
+	ID          string      `json:"id,omitempty"`
+	Name        *struct {
+		FamilyName string `json:"familyName,omitempty"`
+		GivenName  string `json:"givenName,omitempty"`
+	} `json:"name,omitempty"`
+	Schemas  []string `json:"schemas,omitempty"`
+	Title    string   `json:"title,omitempty"`
+	UserName string   `json:"userName"`
+}
+
+type resourceSlackSCIMUser struct {
+	client *slackClient
+}
+
+func NewResourceSlackSCIMUser() resource.Resource {
+	return &resourceSlackSCIMUser{}
+}
+
+func (r *resourceSlackSCIMUser) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackSCIMUser) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_scim_user"
+}
+
+func (r *resourceSlackSCIMUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_scim_user", &resp.Diagnostics) {
+		return
+	}
+
+	var data SCIMUser
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var emails []string
+	resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	active := data.Active.ValueBool()
+	user := scimUser{
+		Active:   &active,
+		Emails:   scimEmails(emails),
+		Schemas:  []string{scimSchemaUser},
+		Title:    data.Title.ValueString(),
+		UserName: data.UserName.ValueString(),
+	}
+	if !data.DisplayName.IsUnknown() {
+		user.DisplayName = data.DisplayName.ValueString()
+	}
+	if !data.GivenName.IsNull() || !data.FamilyName.IsNull() {
+		user.Name = &struct {
+			FamilyName string `json:"familyName,omitempty"`
+			GivenName  string `json:"givenName,omitempty"`
+		}{
+			FamilyName: data.FamilyName.ValueString(),
+			GivenName:  data.GivenName.ValueString(),
+		}
+	}
+
+	var created scimUser
+	if err := r.client.SCIM(ctx, http.MethodPost, "Users", user, &created); err != nil {
+		resp.Diagnostics.AddError("Error creating Slack SCIM user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setSCIMUserState(ctx, &data, &created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Created Slack SCIM user", map[string]interface{}{
+		"id":        data.ID.ValueString(),
+		"user_name": data.UserName.ValueString(),
+	})
+}
+
+// Delete deactivates the user, as Slack never removes a user through the SCIM API.
+func (r *resourceSlackSCIMUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_scim_user", &resp.Diagnostics) {
+		return
+	}
+
+	var data SCIMUser
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SCIM(ctx, http.MethodDelete, "Users/"+data.ID.ValueString(), nil, nil); err != nil {
+		if isSCIMNotFound(err) {
+			tflog.Warn(ctx, "Slack SCIM user not found, assuming it was already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deactivating Slack SCIM user", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deactivated Slack SCIM user", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackSCIMUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceSlackSCIMUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SCIMUser
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user scimUser
+	if err := r.client.SCIM(ctx, http.MethodGet, "Users/"+data.ID.ValueString(), nil, &user); err != nil {
+		if isSCIMNotFound(err) {
+			tflog.Warn(ctx, "Slack SCIM user not found, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack SCIM user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setSCIMUserState(ctx, &data, &user)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackSCIMUser) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_scim_user** resource manages a user of an Enterprise organization through the SCIM 2.0 API.
+
+Changes are sent as SCIM ` + "`PATCH`" + ` requests. Slack never removes a user through the SCIM API, so destroying the resource deactivates the user. The provider ` + "`scim_url`" + ` and ` + "`scim_token`" + ` are used for every request.
+
+Import is supported using the SCIM user ID.
+
+**Required scopes**
+
+User tokens: admin
+`,
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the user. Computed by Slack when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emails": schema.ListAttribute{
+				MarkdownDescription: "The email addresses of the user. The first one is the primary email address.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"family_name": schema.StringAttribute{
+				MarkdownDescription: "The family name of the user.",
+				Optional:            true,
+			},
+			"given_name": schema.StringAttribute{
+				MarkdownDescription: "The given name of the user.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The SCIM ID of the user, which is also their Slack user ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The job title of the user.",
+				Optional:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The unique username of the user.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackSCIMUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_scim_user", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state SCIMUser
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var operations []scimPatchOperation
+	replace := func(attribute string, planned types.String, current types.String) {
+		if !planned.IsUnknown() && !planned.Equal(current) {
+			operations = append(operations, scimPatchOperation{Op: "replace", Path: attribute, Value: planned.ValueString()})
+		}
+	}
+	replace("userName", data.UserName, state.UserName)
+	replace("displayName", data.DisplayName, state.DisplayName)
+	replace("name.givenName", data.GivenName, state.GivenName)
+	replace("name.familyName", data.FamilyName, state.FamilyName)
+	replace("title", data.Title, state.Title)
+
+	if !data.Emails.Equal(state.Emails) {
+		var emails []string
+		resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		operations = append(operations, scimPatchOperation{Op: "replace", Path: "emails", Value: scimEmails(emails)})
+	}
+
+	if !data.Active.Equal(state.Active) {
+		operations = append(operations, scimPatchOperation{Op: "replace", Path: "active", Value: data.Active.ValueBool()})
+	}
+
+	var user scimUser
+	if len(operations) == 0 {
+		if err := r.client.SCIM(ctx, http.MethodGet, "Users/"+state.ID.ValueString(), nil, &user); err != nil {
+			resp.Diagnostics.AddError("Error retrieving Slack SCIM user", err.Error())
+			return
+		}
+	} else if err := r.client.SCIM(ctx, http.MethodPatch, "Users/"+state.ID.ValueString(), newSCIMPatch(operations), &user); err != nil {
+		resp.Diagnostics.AddError("Error updating Slack SCIM user", err.Error())
+		return
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(setSCIMUserState(ctx, &data, &user)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack SCIM user", map[string]interface{}{
+		"id":         data.ID.ValueString(),
+		"operations": len(operations),
+	})
+}
+
+// setSCIMUserState copies a SCIM user into the model, leaving optional attributes that are
+// not set on either side null.
+func setSCIMUserState(ctx context.Context, data *SCIMUser, user *scimUser) diag.Diagnostics {
+	data.ID = types.StringValue(user.ID)
+	data.UserName = types.StringValue(user.UserName)
+	data.DisplayName = types.StringValue(user.DisplayName)
+	data.Active = types.BoolValue(user.Active == nil || *user.Active)
+
+	var givenName, familyName string
+	if user.Name != nil {
+		givenName, familyName = user.Name.GivenName, user.Name.FamilyName
+	}
+	data.GivenName = scimOptionalString(data.GivenName, givenName)
+	data.FamilyName = scimOptionalString(data.FamilyName, familyName)
+	data.Title = scimOptionalString(data.Title, user.Title)
+
+	// the primary email comes first, as in the configuration
+	emails := make([]string, 0, len(user.Emails))
+	for _, email := range user.Emails {
+		if email.Primary {
+			emails = append([]string{email.Value}, emails...)
+		} else {
+			emails = append(emails, email.Value)
+		}
+	}
+
+	var diags diag.Diagnostics
+	data.Emails, diags = types.ListValueFrom(ctx, types.StringType, emails)
+	return diags
+}
+
+// scimEmails returns email addresses as SCIM values, marking the first one as primary.
+func scimEmails(emails []string) []scimValue {
+	values := make([]scimValue, 0, len(emails))
+	for i, email := range emails {
+		values = append(values, scimValue{Primary: i == 0, Value: email})
+	}
+	return values
+}
+
+// scimOptionalString returns a SCIM value for an optional attribute, keeping it null
+// when it is not set in the state and empty in SCIM.
+func scimOptionalString(current types.String, value string) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
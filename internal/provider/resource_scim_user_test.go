@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_scim_user(t *testing.T) {
+	scim := newSCIMStandIn(t)
+
+	checkPatch := func(want int) resource.TestCheckFunc {
+		return testCheckStandIn(&scim.mu, func() error {
+			if len(scim.patches) != want {
+				return fmt.Errorf("expected %d PATCH requests, got %d", want, len(scim.patches))
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		providerConfig: fmt.Sprintf(`
+            scim_token = "xoxp-scim"
+            scim_url   = "%s/scim/v2/"
+        `, scim.URL),
+		checkDestroy: testCheckStandIn(&scim.mu, func() error {
+			if active := scim.resources["Users"]["W0001"]["active"]; active != false {
+				return fmt.Errorf("expected the user to be deactivated, got active %v", active)
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config: testSlackSCIMUserConfig("Engineer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_scim_user.test", "id", "W0001"),
+					resource.TestCheckResourceAttr("slack_scim_user.test", "active", "true"),
+					resource.TestCheckResourceAttr("slack_scim_user.test", "emails.0", "alice@example.com"),
+					resource.TestCheckResourceAttr("slack_scim_user.test", "emails.1", "alice@example.org"),
+					resource.TestCheckResourceAttr("slack_scim_user.test", "given_name", "Alice"),
+					resource.TestCheckResourceAttr("slack_scim_user.test", "title", "Engineer"),
+					checkPatch(0),
+				),
+			},
+			{
+				Config: testSlackSCIMUserConfig("Staff Engineer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_scim_user.test", "title", "Staff Engineer"),
+					checkPatch(1),
+				),
+			},
+			{
+				ResourceName:      "slack_scim_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testSlackSCIMUserConfig(title string) string {
+	return fmt.Sprintf(`
+        resource "slack_scim_user" "test" {
+            user_name    = "alice"
+            display_name = "alice"
+            emails       = ["alice@example.com", "alice@example.org"]
+            given_name   = "Alice"
+            family_name  = "Example"
+            title        = "%s"
+        }
+    `, title)
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-slack/internal/slackutil"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackTeamSettings)(nil)
+	_ resource.ResourceWithImportState    = (*resourceSlackTeamSettings)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackTeamSettings)(nil)
+)
+
+// teamDiscoverabilities are the values accepted by admin.teams.settings.setDiscoverability.
+var teamDiscoverabilities = []string{"open", "invite_only", "closed", "unlisted"}
+
+type TeamSettings struct {
+	DefaultChannelIDs types.Set    `tfsdk:"default_channel_ids"`
+	DefaultChannels   types.Set    `tfsdk:"default_channels"`
+	Description       types.String `tfsdk:"description"`
+	Discoverability   types.String `tfsdk:"discoverability"`
+	IconURL           types.String `tfsdk:"icon_url"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	TeamID            types.String `tfsdk:"team_id"`
+}
+
+type resourceSlackTeamSettings struct {
+	client *slackClient
+}
+
+func NewResourceSlackTeamSettings() resource.Resource {
+	return &resourceSlackTeamSettings{}
+}
+
+func (r *resourceSlackTeamSettings) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackTeamSettings) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_team_settings"
+}
+
+func (r *resourceSlackTeamSettings) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamSettings
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Discoverability.IsNull() && !data.Discoverability.IsUnknown() && !slices.Contains(teamDiscoverabilities, data.Discoverability.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("discoverability"),
+			"Invalid Discoverability",
+			fmt.Sprintf("The discoverability must be one of %s, got %q.", strings.Join(teamDiscoverabilities, ", "), data.Discoverability.ValueString()),
+		)
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() && strings.TrimSpace(data.Name.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Team Name", "The team name must not be empty.")
+	}
+}
+
+func (r *resourceSlackTeamSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_team_settings", &resp.Diagnostics) {
+		return
+	}
+
+	var data TeamSettings
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute `team_id` if it’s not defined, inheriting the provider default first
+	teamID := r.client.ResolveTeamID(data.TeamID)
+	if teamID == "" {
+		teamInfo, err := slackutil.GetTeamInfo(r.client.Client, "")
+		if err != nil {
+			resp.Diagnostics.AddError("Team ID Retrieval Error", fmt.Sprintf("Failed to compute team ID: %v", err))
+			return
+		}
+		teamID = teamInfo.ID
+	}
+	data.TeamID = types.StringValue(teamID)
+	data.ID = types.StringValue(teamID)
+
+	if !r.apply(ctx, &data, &TeamSettings{}, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Applied Slack team settings", map[string]interface{}{
+		"team_id": teamID,
+	})
+}
+
+// Delete only removes the settings from the state, as Slack has no defaults to restore them to.
+func (r *resourceSlackTeamSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_team_settings", &resp.Diagnostics) {
+		return
+	}
+
+	var data TeamSettings
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Slack team settings are left in place", map[string]interface{}{
+		"team_id": data.TeamID.ValueString(),
+	})
+}
+
+func (r *resourceSlackTeamSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+}
+
+func (r *resourceSlackTeamSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamSettings
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Team struct {
+			DefaultChannels []string `json:"default_channels"`
+			Description     string   `json:"description"`
+			Discoverability string   `json:"discoverability"`
+			Name            string   `json:"name"`
+		} `json:"team"`
+	}
+	if err := r.client.Call(ctx, "admin.teams.settings.info", url.Values{"team_id": {data.TeamID.ValueString()}}, &result); err != nil {
+		if isSlackError(err, "team_not_found") {
+			tflog.Warn(ctx, "Slack team not found, removing its settings from state", map[string]interface{}{
+				"team_id": data.TeamID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack team settings", err.Error())
+		return
+	}
+
+	// only the settings that are managed are read, the icon cannot be compared as Slack
+	// stores a copy of the image
+	if !data.Name.IsNull() {
+		data.Name = types.StringValue(result.Team.Name)
+	}
+	if !data.Description.IsNull() {
+		data.Description = types.StringValue(result.Team.Description)
+	}
+	if !data.Discoverability.IsNull() {
+		data.Discoverability = types.StringValue(result.Team.Discoverability)
+	}
+
+	if !data.DefaultChannels.IsNull() {
+		// default channels are configured by name, so they are only replaced by the IDs
+		// when the workspace no longer matches the configuration
+		channelIDs, diags := types.SetValueFrom(ctx, types.StringType, result.Team.DefaultChannels)
+		resp.Diagnostics.Append(diags...)
+		if !channelIDs.Equal(data.DefaultChannelIDs) {
+			data.DefaultChannels = channelIDs
+		}
+		data.DefaultChannelIDs = channelIDs
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackTeamSettings) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_team_settings** resource manages the settings of a workspace of an Enterprise Grid organization: its name, description, discoverability, icon and default channels, which new members join automatically.
+
+Only the settings that are set are managed. Destroying the resource leaves the settings in place.
+
+Import is supported using the team ID.
+
+**Required scopes**
+
+User tokens: admin.teams:read, admin.teams:write, channels:read, groups:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"default_channel_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the default channels.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"default_channels": schema.SetAttribute{
+				MarkdownDescription: "The names or IDs of the channels new members join automatically.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the workspace.",
+				Optional:            true,
+			},
+			"discoverability": schema.StringAttribute{
+				MarkdownDescription: "Who can find and join the workspace: `open`, `invite_only`, `closed` or `unlisted`.",
+				Optional:            true,
+			},
+			"icon_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of an image to use as the workspace icon. Changes made outside Terraform are not detected.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workspace.",
+				Optional:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace. Defaults to the provider `team_id`, or the workspace of the token.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackTeamSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_team_settings", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state TeamSettings
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamID = state.TeamID
+	data.ID = state.ID
+
+	if !r.apply(ctx, &data, &state, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack team settings", map[string]interface{}{
+		"team_id": data.TeamID.ValueString(),
+	})
+}
+
+// apply sends the settings that are set and differ from the prior state.
+func (r *resourceSlackTeamSettings) apply(ctx context.Context, data *TeamSettings, state *TeamSettings, diags *diag.Diagnostics) bool {
+	teamID := data.TeamID.ValueString()
+
+	for _, setting := range []struct {
+		method  string
+		param   string
+		planned types.String
+		current types.String
+	}{
+		{"admin.teams.settings.setName", "name", data.Name, state.Name},
+		{"admin.teams.settings.setDescription", "description", data.Description, state.Description},
+		{"admin.teams.settings.setDiscoverability", "discoverability", data.Discoverability, state.Discoverability},
+		{"admin.teams.settings.setIcon", "image_url", data.IconURL, state.IconURL},
+	} {
+		if setting.planned.IsNull() || setting.planned.Equal(setting.current) {
+			continue
+		}
+		if err := r.client.Call(ctx, setting.method, url.Values{
+			"team_id":     {teamID},
+			setting.param: {setting.planned.ValueString()},
+		}, nil); err != nil {
+			diags.AddError("Error updating Slack team settings", fmt.Sprintf("%s failed: %s", setting.method, err.Error()))
+			return false
+		}
+	}
+
+	if data.DefaultChannels.IsNull() {
+		data.DefaultChannelIDs = types.SetNull(types.StringType)
+		return true
+	}
+
+	var channels []string
+	diags.Append(data.DefaultChannels.ElementsAs(ctx, &channels, false)...)
+	if diags.HasError() {
+		return false
+	}
+
+	// translate conversation names to ids
+	var names, channelIDs []string
+	for _, channel := range channels {
+		if slackutil.IsConversationId(channel) {
+			channelIDs = append(channelIDs, channel)
+		} else {
+			names = append(names, strings.TrimPrefix(channel, "#"))
+		}
+	}
+	conversationIds, err := slackutil.GetConversationIds(r.client.Client, names, messageConversationTypes, 1000, teamID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("default_channels"), "Channel Retrieval Error", fmt.Sprintf("Failed to retrieve conversation IDs: %v", err))
+		return false
+	}
+	channelIDs = append(channelIDs, conversationIds...)
+	slices.Sort(channelIDs)
+	channelIDs = slices.Compact(channelIDs)
+
+	var d diag.Diagnostics
+	data.DefaultChannelIDs, d = types.SetValueFrom(ctx, types.StringType, channelIDs)
+	diags.Append(d...)
+
+	if data.DefaultChannelIDs.Equal(state.DefaultChannelIDs) {
+		return true
+	}
+	if err := r.client.Call(ctx, "admin.teams.settings.setDefaultChannels", url.Values{
+		"team_id":     {teamID},
+		"channel_ids": {strings.Join(channelIDs, ",")},
+	}, nil); err != nil {
+		diags.AddError("Error updating Slack team settings", fmt.Sprintf("admin.teams.settings.setDefaultChannels failed: %s", err.Error()))
+		return false
+	}
+
+	return true
+}
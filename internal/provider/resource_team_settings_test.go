@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_team_settings(t *testing.T) {
+	// local stand-in keeping the settings of the workspace in memory
+	var mu sync.Mutex
+	settings := map[string]any{"name": "Acme", "description": "", "discoverability": "closed", "default_channels": []string{}}
+	icons := 0
+
+	set := func(param string, setting string) func(r *http.Request) string {
+		return func(r *http.Request) string {
+			mu.Lock()
+			defer mu.Unlock()
+			if r.Form.Get("team_id") != "T0TEAM" {
+				return `{"ok": false, "error": "team_not_found"}`
+			}
+			settings[setting] = r.Form.Get(param)
+			return `{"ok": true}`
+		}
+	}
+
+	checkSetting := func(setting string, want string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if got := fmt.Sprint(settings[setting]); got != want {
+				return fmt.Errorf("expected %s %q, got %q", setting, want, got)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"team.info": func(r *http.Request) string {
+				return `{"ok": true, "team": {"id": "T0TEAM", "name": "Acme"}}`
+			},
+			"conversations.list": func(r *http.Request) string {
+				return `{"ok": true, "channels": [{"id": "C0GENERAL", "name": "general", "is_channel": true}, {"id": "C0WELCOME", "name": "welcome", "is_channel": true}]}`
+			},
+			"admin.teams.settings.setName":            set("name", "name"),
+			"admin.teams.settings.setDescription":     set("description", "description"),
+			"admin.teams.settings.setDiscoverability": set("discoverability", "discoverability"),
+			"admin.teams.settings.setIcon": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				icons++
+				return `{"ok": true}`
+			},
+			"admin.teams.settings.setDefaultChannels": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				settings["default_channels"] = strings.Split(r.Form.Get("channel_ids"), ",")
+				return `{"ok": true}`
+			},
+			"admin.teams.settings.info": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				team := map[string]any{"id": "T0TEAM"}
+				for key, value := range settings {
+					team[key] = value
+				}
+				body, _ := json.Marshal(map[string]any{"ok": true, "team": team})
+				return string(body)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config:      testSlackTeamSettingsConfig(`discoverability = "public"`),
+				ExpectError: regexp.MustCompile("Invalid Discoverability"),
+			},
+			{
+				Config: testSlackTeamSettingsConfig(`
+                    name             = "Acme Corp"
+                    discoverability  = "invite_only"
+                    icon_url         = "https://example.com/acme.png"
+                    default_channels = ["#general", "welcome"]
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_team_settings.test", "id", "T0TEAM"),
+					resource.TestCheckResourceAttr("slack_team_settings.test", "default_channel_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("slack_team_settings.test", "default_channel_ids.*", "C0WELCOME"),
+					checkSetting("name", "Acme Corp"),
+					checkSetting("discoverability", "invite_only"),
+					checkSetting("default_channels", "[C0GENERAL C0WELCOME]"),
+				),
+			},
+			{
+				// settings changed outside Terraform are set again, the icon is not sent again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					settings["name"] = "Acme"
+					settings["default_channels"] = []string{"C0GENERAL"}
+				},
+				Config: testSlackTeamSettingsConfig(`
+                    name             = "Acme Corp"
+                    discoverability  = "invite_only"
+                    icon_url         = "https://example.com/acme.png"
+                    default_channels = ["#general", "welcome"]
+                `),
+				Check: resource.ComposeTestCheckFunc(
+					checkSetting("name", "Acme Corp"),
+					checkSetting("default_channels", "[C0GENERAL C0WELCOME]"),
+					testCheckStandIn(&mu, func() error {
+						if icons != 1 {
+							return fmt.Errorf("expected the icon to be set once, got %d", icons)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testSlackTeamSettingsConfig(settings string) string {
+	return fmt.Sprintf(`
+        resource "slack_team_settings" "test" {
+            %s
+        }
+    `, settings)
+}
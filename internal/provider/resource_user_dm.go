@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackUserDm)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackUserDm)(nil)
+)
+
+// userDmMaxUsers is the number of other users conversations.open accepts for a group DM.
+const userDmMaxUsers = 8
+
+type UserDm struct {
+	ChannelID types.String `tfsdk:"channel_id"`
+	ID        types.String `tfsdk:"id"`
+	IsGroup   types.Bool   `tfsdk:"is_group"`
+	UserIDs   types.Set    `tfsdk:"user_ids"`
+	Users     types.Set    `tfsdk:"users"`
+}
+
+type resourceSlackUserDm struct {
+	client *slackClient
+}
+
+func NewResourceSlackUserDm() resource.Resource {
+	return &resourceSlackUserDm{}
+}
+
+func (r *resourceSlackUserDm) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackUserDm) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_user_dm"
+}
+
+func (r *resourceSlackUserDm) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserDm
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Users.IsNull() || data.Users.IsUnknown() {
+		return
+	}
+
+	if n := len(data.Users.Elements()); n < 1 || n > userDmMaxUsers {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("users"),
+			"Invalid Users",
+			fmt.Sprintf("A DM must be opened with between 1 and %d users, got %d.", userDmMaxUsers, n),
+		)
+	}
+}
+
+func (r *resourceSlackUserDm) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_dm", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserDm
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs, err := r.client.ResolveUserIDs(users)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Retrieving UserIds", err.Error())
+		return
+	}
+
+	channel, _, _, err := r.client.OpenConversationContext(ctx, &slack.OpenConversationParameters{Users: userIDs})
+	if err != nil {
+		resp.Diagnostics.AddError("Error opening Slack DM", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(channel.ID)
+	data.ChannelID = types.StringValue(channel.ID)
+	data.IsGroup = types.BoolValue(len(userIDs) > 1)
+
+	var diags diag.Diagnostics
+	data.UserIDs, diags = types.SetValueFrom(ctx, types.StringType, userIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Opened Slack DM", map[string]interface{}{
+		"id":       channel.ID,
+		"user_ids": strings.Join(userIDs, ","),
+	})
+}
+
+func (r *resourceSlackUserDm) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_dm", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserDm
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, _, err := r.client.CloseConversationContext(ctx, data.ID.ValueString()); err != nil {
+		if isSlackError(err, "channel_not_found") {
+			tflog.Warn(ctx, "Slack DM not found, assuming it was already closed", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error closing Slack DM", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Closed Slack DM", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackUserDm) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserDm
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{ChannelID: data.ID.ValueString()})
+	if err != nil {
+		if isSlackError(err, "channel_not_found") {
+			tflog.Warn(ctx, "Slack DM not found, removing it from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack DM", err.Error())
+		return
+	}
+
+	data.ChannelID = types.StringValue(channel.ID)
+	data.IsGroup = types.BoolValue(channel.IsMpIM)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackUserDm) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_user_dm** resource opens a direct message (DM) with a Slack user, or a group DM with several users, and exposes its channel ID.
+
+The DM is opened between the users and the user the API token belongs to. Other resources, such as **slack_message** or **slack_conversation_bookmark**, can target the user directly with the ` + "`channel_id`" + `. Destroying the resource closes the DM.
+
+**Required scopes**
+
+Bot tokens: im:write, mpim:write, im:read, mpim:read, users:read, users:read.email
+
+User tokens: im:write, mpim:write, im:read, mpim:read, users:read, users:read.email
+`,
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the DM conversation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the DM conversation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_group": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DM is a group DM with more than one other user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the users the DM is opened with.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("The email addresses or IDs of the users to open the DM with, up to %d users.", userDmMaxUsers),
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Update is never called with a change, as changing the users opens a new DM.
+func (r *resourceSlackUserDm) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_dm", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserDm
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
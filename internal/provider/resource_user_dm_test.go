@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_user_dm(t *testing.T) {
+	// local stand-in keeping the open DMs in memory
+	var mu sync.Mutex
+	open := map[string]string{}
+
+	checkUsers := func(id string, want string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if open[id] != want {
+				return fmt.Errorf("expected %s to be opened with %s, got %q", id, want, open[id])
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"users.list": func(r *http.Request) string {
+				return `{"ok": true, "members": [{"id": "U0ALICE", "profile": {"email": "alice@example.com"}}, {"id": "U0BOB", "profile": {"email": "bob@example.com"}}]}`
+			},
+			"conversations.open": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				users := r.Form.Get("users")
+				id := "D0ALICE"
+				if strings.Contains(users, ",") {
+					id = "G0GROUP"
+				}
+				open[id] = users
+				return fmt.Sprintf(`{"ok": true, "channel": {"id": "%s"}}`, id)
+			},
+			"conversations.info": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				id := r.Form.Get("channel")
+				if _, ok := open[id]; !ok {
+					return `{"ok": false, "error": "channel_not_found"}`
+				}
+				return fmt.Sprintf(`{"ok": true, "channel": {"id": "%s", "is_im": %t, "is_mpim": %t}}`, id, id == "D0ALICE", id == "G0GROUP")
+			},
+			"conversations.close": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				delete(open, r.Form.Get("channel"))
+				return `{"ok": true}`
+			},
+		},
+		checkDestroy: testCheckStandIn(&mu, func() error {
+			if len(open) != 0 {
+				return fmt.Errorf("expected all DMs to be closed, got %d", len(open))
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config:      testSlackUserDmConfig(`[]`),
+				ExpectError: regexp.MustCompile(`Invalid Users`),
+			},
+			{
+				Config: testSlackUserDmConfig(`["alice@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_dm.test", "channel_id", "D0ALICE"),
+					resource.TestCheckResourceAttr("slack_user_dm.test", "is_group", "false"),
+					resource.TestCheckResourceAttr("slack_user_dm.test", "user_ids.#", "1"),
+					checkUsers("D0ALICE", "U0ALICE"),
+				),
+			},
+			{
+				// changing the users closes the DM and opens a group DM
+				Config: testSlackUserDmConfig(`["alice@example.com", "U0BOB"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_dm.test", "channel_id", "G0GROUP"),
+					resource.TestCheckResourceAttr("slack_user_dm.test", "is_group", "true"),
+					checkUsers("G0GROUP", "U0ALICE,U0BOB"),
+					checkUsers("D0ALICE", ""),
+				),
+			},
+		},
+	})
+}
+
+func testSlackUserDmConfig(users string) string {
+	return fmt.Sprintf(`
+        resource "slack_user_dm" "test" {
+            users = %s
+        }
+    `, users)
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource = (*resourceSlackUserDnd)(nil)
+)
+
+type resourceSlackUserDnd struct {
+	client *slackClient
+}
+
+type UserDnd struct {
+	ID            types.String `tfsdk:"id"`
+	SnoozeEnabled types.Bool   `tfsdk:"snooze_enabled"`
+	SnoozeEndTime types.Int64  `tfsdk:"snooze_endtime"`
+	SnoozeMinutes types.Int64  `tfsdk:"snooze_minutes"`
+}
+
+func NewResourceSlackUserDnd() resource.Resource {
+	return &resourceSlackUserDnd{}
+}
+
+func (r *resourceSlackUserDnd) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackUserDnd) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_user_dnd"
+}
+
+func (r *resourceSlackUserDnd) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_dnd", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserDnd
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the method always applies to the user the token belongs to
+	authTest, err := r.client.AuthTestContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Slack API AuthTest failed", err.Error())
+		return
+	}
+	data.ID = types.StringValue(authTest.UserID)
+
+	dnd, err := r.client.SetSnoozeContext(ctx, int(data.SnoozeMinutes.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error snoozing Slack notifications", err.Error())
+		return
+	}
+
+	data.SnoozeEnabled = types.BoolValue(dnd.SnoozeEnabled)
+	data.SnoozeEndTime = types.Int64Value(int64(dnd.SnoozeEndTime))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Slack notifications snoozed", map[string]interface{}{
+		"id":             data.ID.ValueString(),
+		"snooze_minutes": data.SnoozeMinutes.ValueInt64(),
+		"snooze_endtime": dnd.SnoozeEndTime,
+	})
+}
+
+func (r *resourceSlackUserDnd) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_dnd", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserDnd
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.EndSnoozeContext(ctx)
+	if err != nil {
+		if isSlackError(err, "snooze_not_active", "snooze_end_failed") {
+			tflog.Warn(ctx, "Slack snooze not active, assuming it already ended", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error ending Slack snooze", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Slack snooze ended", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackUserDnd) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserDnd
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.ID.ValueString()
+	dnd, err := r.client.GetDNDInfoContext(ctx, &userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack do not disturb status", fmt.Sprintf("Error: %s", err.Error()))
+		return
+	}
+
+	// an ended or expired snooze is snoozed again on the next apply
+	if !dnd.SnoozeEnabled {
+		tflog.Warn(ctx, "Slack snooze is no longer active, removing it from state", map[string]interface{}{
+			"id": userID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.SnoozeEnabled = types.BoolValue(dnd.SnoozeEnabled)
+	data.SnoozeEndTime = types.Int64Value(int64(dnd.SnoozeEndTime))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack do not disturb status", map[string]interface{}{
+		"id":             userID,
+		"snooze_endtime": dnd.SnoozeEndTime,
+	})
+}
+
+func (r *resourceSlackUserDnd) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_user_dnd** resource snoozes the notifications of a Slack user for a number of minutes.
+
+The snooze applies to the user the API token belongs to. A snooze that has ended or expired is started again on the next apply, and destroying the resource ends the snooze.
+
+**Required scopes**
+
+User tokens: dnd:read, dnd:write
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the API token belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snooze_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user's notifications are snoozed.",
+				Computed:            true,
+			},
+			"snooze_endtime": schema.Int64Attribute{
+				MarkdownDescription: "The timestamp (epoch) when the snooze ends.",
+				Computed:            true,
+			},
+			"snooze_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The number of minutes to snooze notifications for.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackUserDnd) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_dnd", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserDnd
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnd, err := r.client.SetSnoozeContext(ctx, int(data.SnoozeMinutes.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Slack snooze", err.Error())
+		return
+	}
+
+	data.SnoozeEnabled = types.BoolValue(dnd.SnoozeEnabled)
+	data.SnoozeEndTime = types.Int64Value(int64(dnd.SnoozeEndTime))
+
+	tflog.Trace(ctx, "Slack snooze updated", map[string]interface{}{
+		"id":             data.ID.ValueString(),
+		"snooze_minutes": data.SnoozeMinutes.ValueInt64(),
+		"snooze_endtime": dnd.SnoozeEndTime,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_user_dnd(t *testing.T) {
+	// local stand-in keeping the snooze in memory
+	var mu sync.Mutex
+	snoozed := false
+	minutes := ""
+
+	dndInfo := func() string {
+		if !snoozed {
+			return `{"ok": true, "dnd_enabled": false, "snooze_enabled": false}`
+		}
+		return `{"ok": true, "dnd_enabled": false, "snooze_enabled": true, "snooze_endtime": 1900000000, "snooze_remaining": 3600}`
+	}
+
+	checkMinutes := func(want string) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if minutes != want {
+				return fmt.Errorf("expected num_minutes %s, got %q", want, minutes)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"dnd.setSnooze": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				snoozed = true
+				minutes = r.Form.Get("num_minutes")
+				return dndInfo()
+			},
+			"dnd.endSnooze": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if !snoozed {
+					return `{"ok": false, "error": "snooze_not_active"}`
+				}
+				snoozed = false
+				return dndInfo()
+			},
+			"dnd.info": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				return dndInfo()
+			},
+		},
+		checkDestroy: testCheckStandIn(&mu, func() error {
+			if snoozed {
+				return fmt.Errorf("expected the snooze to be ended")
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config: testSlackUserDndConfig(60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_dnd.test", "id", "U0BOT"),
+					resource.TestCheckResourceAttr("slack_user_dnd.test", "snooze_enabled", "true"),
+					resource.TestCheckResourceAttr("slack_user_dnd.test", "snooze_endtime", "1900000000"),
+					checkMinutes("60"),
+				),
+			},
+			{
+				Config: testSlackUserDndConfig(120),
+				Check:  checkMinutes("120"),
+			},
+			{
+				// an ended snooze is snoozed again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					snoozed = false
+					minutes = ""
+				},
+				Config: testSlackUserDndConfig(120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_dnd.test", "snooze_enabled", "true"),
+					checkMinutes("120"),
+				),
+			},
+		},
+	})
+}
+
+func testSlackUserDndConfig(minutes int) string {
+	return fmt.Sprintf(`
+        resource "slack_user_dnd" "test" {
+            snooze_minutes = %d
+        }
+    `, minutes)
+}
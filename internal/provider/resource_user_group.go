@@ -66,7 +66,7 @@ type UserGroupSimple struct {
 }
 
 type resourceSlackUserGroup struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewResourceSlackUserGroup() resource.Resource {
@@ -80,7 +80,7 @@ func (r *resourceSlackUserGroup) Configure(ctx context.Context, req resource.Con
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		r.client = providerClient.Client
+		r.client = providerClient
 	}
 }
 
@@ -89,6 +89,10 @@ func (r *resourceSlackUserGroup) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *resourceSlackUserGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_group", &resp.Diagnostics) {
+		return
+	}
+
 	// Get attributes
 	configAutoType, _ := slackutil.GetConfigAttribute[types.String](ctx, req.Config, "auto_type", &resp.Diagnostics)
 	configChannels, configChannelsIsDefined := slackutil.GetConfigAttribute[[]string](ctx, req.Config, "channels", &resp.Diagnostics)
@@ -113,19 +117,12 @@ func (r *resourceSlackUserGroup) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// translate conversation names to ids
-	conversationIds, err := slackutil.GetConversationIds(r.client, configChannels, []string{"public_channel", "private_channel"}, 1000)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Channel Retrieval Error on Create",
-			fmt.Sprintf("Failed to retrieve conversation IDs: %v", err),
-		)
-		return
-	}
-
-	// Compute `team_id` if it’s not defined
+	// Compute `team_id` if it’s not defined, inheriting the provider default first
 	if !configTeamIdIsDefined {
-		teamInfo, err := slackutil.GetTeamInfo(r.client)
+		configTeamId = types.StringValue(r.client.TeamID)
+	}
+	if configTeamId.ValueString() == "" {
+		teamInfo, err := slackutil.GetTeamInfo(r.client.Client, "")
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Team ID Retrieval Error",
@@ -135,10 +132,21 @@ func (r *resourceSlackUserGroup) Create(ctx context.Context, req resource.Create
 		}
 		configTeamId = types.StringValue(teamInfo.ID)
 	}
+	teamID := configTeamId.ValueString()
+
+	// translate conversation names to ids
+	conversationIds, err := slackutil.GetConversationIds(r.client.Client, configChannels, []string{"public_channel", "private_channel"}, 1000, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Channel Retrieval Error on Create",
+			fmt.Sprintf("Failed to retrieve conversation IDs: %v", err),
+		)
+		return
+	}
 
 	var userGroupSimple UserGroupSimple
 
-	userGroupInfo, _, _ := slackutil.GetUserGroupByName(r.client, configName)
+	userGroupInfo, _, _ := slackutil.GetUserGroupByName(r.client.Client, configName, teamID)
 	if userGroupInfo.ID == "" {
 		// new
 		resp.Diagnostics.AddWarning(
@@ -246,7 +254,7 @@ func (r *resourceSlackUserGroup) Create(ctx context.Context, req resource.Create
 	}
 
 	// translate id to email
-	usersInfo, err := slackutil.GetUserEmails(r.client, userGroupSimple.UsersId)
+	usersInfo, err := slackutil.GetUserEmails(r.client.Client, userGroupSimple.UsersId, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Id lookup error",
@@ -285,10 +293,11 @@ func (r *resourceSlackUserGroup) Create(ctx context.Context, req resource.Create
 	if !configChannelsIsDefined || len(userGroupSimple.Channels) == 0 {
 		data.Channels = types.ListNull(types.StringType)
 	} else {
-		conversationNames, err := slackutil.GetConversationNames(r.client,
+		conversationNames, err := slackutil.GetConversationNames(r.client.Client,
 			userGroupSimple.Channels,
 			[]string{"public_channel", "private_channel"},
-			1000)
+			1000,
+			teamID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Channel Retrieval Error on Create from Existing",
@@ -340,6 +349,10 @@ func (r *resourceSlackUserGroup) Create(ctx context.Context, req resource.Create
 }
 
 func (r *resourceSlackUserGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_group", &resp.Diagnostics) {
+		return
+	}
+
 	var data UserGroup
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -400,10 +413,13 @@ func (r *resourceSlackUserGroup) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	teamID := r.client.ResolveTeamID(data.TeamID)
+
 	// sdk does not have a group, need to fetch all and filter
 	userGroups, err := r.client.GetUserGroups(
 		slack.GetUserGroupsOptionIncludeUsers(true),
 		slack.GetUserGroupsOptionIncludeCount(true),
+		slack.GetUserGroupsOptionWithTeamID(teamID),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching user groups from Slack", err.Error())
@@ -427,7 +443,7 @@ func (r *resourceSlackUserGroup) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// translate id to email
-	usersInfo, err := slackutil.GetUserEmails(r.client, userGroup.Users)
+	usersInfo, err := slackutil.GetUserEmails(r.client.Client, userGroup.Users, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Id lookup error",
@@ -464,7 +480,7 @@ func (r *resourceSlackUserGroup) Read(ctx context.Context, req resource.ReadRequ
 
 	// handle if empty, set to null
 	if len(userGroup.Prefs.Channels) > 0 {
-		conversationNames, err := slackutil.GetConversationNames(r.client, userGroup.Prefs.Channels, []string{"public_channel", "private_channel"}, 1000)
+		conversationNames, err := slackutil.GetConversationNames(r.client.Client, userGroup.Prefs.Channels, []string{"public_channel", "private_channel"}, 1000, teamID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Channel Retrieval Error on Read",
@@ -607,7 +623,7 @@ User tokens: users:read, usergroups:write, team:read
 				},
 			},
 			"team_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the team associated with the Slack user group.",
+				MarkdownDescription: "The ID of the team associated with the Slack user group. Defaults to the provider `team_id`, or the workspace of the token when neither is set.",
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
@@ -646,6 +662,10 @@ User tokens: users:read, usergroups:write, team:read
 }
 
 func (r *resourceSlackUserGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_group", &resp.Diagnostics) {
+		return
+	}
+
 	// Get attributes
 	configChannels, configChannelsIsDefined := slackutil.GetConfigAttribute[[]string](ctx, req.Config, "channels", &resp.Diagnostics)
 	configDescription, _ := slackutil.GetConfigAttribute[types.String](ctx, req.Config, "description", &resp.Diagnostics)
@@ -659,13 +679,15 @@ func (r *resourceSlackUserGroup) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	teamID := r.client.ResolveTeamID(data.TeamID)
+
 	var channels []string
 	// Check if configChannels is defined and not empty
 	if !configChannelsIsDefined || len(configChannels) == 0 {
 		channels = nil
 	} else {
 		// translate conversation names to ids
-		conversationIds, err := slackutil.GetConversationIds(r.client, configChannels, []string{"public_channel", "private_channel"}, 1000)
+		conversationIds, err := slackutil.GetConversationIds(r.client.Client, configChannels, []string{"public_channel", "private_channel"}, 1000, teamID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Channel Retrieval Error on Pre-Update",
@@ -698,7 +720,7 @@ func (r *resourceSlackUserGroup) Update(ctx context.Context, req resource.Update
 	}
 
 	// Translate id to email
-	usersInfo, err := slackutil.GetUserEmails(r.client, userGroup.Users)
+	usersInfo, err := slackutil.GetUserEmails(r.client.Client, userGroup.Users, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Id lookup error",
@@ -736,7 +758,7 @@ func (r *resourceSlackUserGroup) Update(ctx context.Context, req resource.Update
 	if !configChannelsIsDefined || len(userGroup.Prefs.Channels) == 0 {
 		data.Channels = types.ListNull(types.StringType)
 	} else {
-		conversationNames, err := slackutil.GetConversationNames(r.client, userGroup.Prefs.Channels, []string{"public_channel", "private_channel"}, 1000)
+		conversationNames, err := slackutil.GetConversationNames(r.client.Client, userGroup.Prefs.Channels, []string{"public_channel", "private_channel"}, 1000, teamID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Channel Retrieval Error on Post-Update",
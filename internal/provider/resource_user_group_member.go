@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/slack-go/slack"
 )
 
 var (
@@ -20,7 +19,7 @@ var (
 )
 
 type resourceUserGroupMember struct {
-	client *slack.Client
+	client *slackClient
 }
 
 func NewResourceSlackUserGroupMember() resource.Resource {
@@ -34,7 +33,7 @@ func (r *resourceUserGroupMember) Configure(ctx context.Context, req resource.Co
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		r.client = providerClient.Client
+		r.client = providerClient
 	}
 }
 
@@ -43,6 +42,10 @@ func (r *resourceUserGroupMember) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *resourceUserGroupMember) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_group_member", &resp.Diagnostics) {
+		return
+	}
+
 	// Get attributes
 	configUsergroupName, ok := slackutil.GetConfigAttribute[types.String](ctx, req.Config, "usergroup", &resp.Diagnostics)
 	if !ok {
@@ -56,6 +59,9 @@ func (r *resourceUserGroupMember) Create(ctx context.Context, req resource.Creat
 	if !ok {
 		return
 	}
+	configTeamId, _ := slackutil.GetConfigAttribute[types.String](ctx, req.Config, "team_id", &resp.Diagnostics)
+
+	teamID := r.client.ResolveTeamID(configTeamId)
 
 	// Convert to []string
 	defaultUserEmail := []string{configDefaultUserEmail.ValueString()}
@@ -96,7 +102,7 @@ func (r *resourceUserGroupMember) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	newUsers, err := slackutil.GetUserIds(r.client, uniqueNewUsersEmail)
+	newUsers, err := slackutil.GetUserIds(r.client.Client, uniqueNewUsersEmail, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Retrieving UserIds",
@@ -120,7 +126,7 @@ func (r *resourceUserGroupMember) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Fetch user group attributes
-	uga, err := slackutil.GetUserGroupAttributes(r.client, configUsergroupName.ValueString())
+	uga, err := slackutil.GetUserGroupAttributes(r.client.Client, configUsergroupName.ValueString(), teamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting user group attributes", err.Error())
 		return
@@ -135,15 +141,17 @@ func (r *resourceUserGroupMember) Create(ctx context.Context, req resource.Creat
 
 	// Prepare the state to store updated values
 	var state struct {
-		UserGroup   string   `tfsdk:"usergroup"`
-		DefaultUser string   `tfsdk:"default_user"`
-		Users       []string `tfsdk:"users"`
+		UserGroup   string       `tfsdk:"usergroup"`
+		DefaultUser string       `tfsdk:"default_user"`
+		Users       []string     `tfsdk:"users"`
+		TeamID      types.String `tfsdk:"team_id"`
 	}
 
 	// Set the state with the updated group name and users
 	state.UserGroup = uga.Name
 	state.DefaultUser = strings.Join(defaultUserEmail, "")
 	state.Users = configUsersEmail
+	state.TeamID = types.StringValue(teamID)
 
 	// Save the state
 	diags := resp.State.Set(ctx, state)
@@ -151,6 +159,10 @@ func (r *resourceUserGroupMember) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *resourceUserGroupMember) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_group_member", &resp.Diagnostics) {
+		return
+	}
+
 	// get attributes
 	configUsergroupName, ok := slackutil.GetConfigAttribute[types.String](ctx, req.State, "usergroup", &resp.Diagnostics)
 	if !ok {
@@ -160,19 +172,22 @@ func (r *resourceUserGroupMember) Delete(ctx context.Context, req resource.Delet
 	if !ok {
 		return
 	}
+	stateTeamId, _ := slackutil.GetConfigAttribute[types.String](ctx, req.State, "team_id", &resp.Diagnostics)
+
+	teamID := r.client.ResolveTeamID(stateTeamId)
 
 	// Convert to []string
 	defaultUserEmail := []string{configDefaultUserEmail.ValueString()}
 
 	// Get user group attributes
-	uga, err := slackutil.GetUserGroupAttributes(r.client, configUsergroupName.ValueString())
+	uga, err := slackutil.GetUserGroupAttributes(r.client.Client, configUsergroupName.ValueString(), teamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting user group attributes", err.Error())
 		return
 	}
 
 	// Get default user attributes using default user email
-	defaultUser, err := slackutil.GetUserAttributes(r.client, "email", strings.Join(defaultUserEmail, ""))
+	defaultUser, err := slackutil.GetUserAttributes(r.client.Client, "email", strings.Join(defaultUserEmail, ""), teamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting default user attributes", err.Error())
 		return
@@ -196,8 +211,11 @@ func (r *resourceUserGroupMember) Read(ctx context.Context, req resource.ReadReq
 	if !ok {
 		return
 	}
+	stateTeamId, _ := slackutil.GetConfigAttribute[types.String](ctx, req.State, "team_id", &resp.Diagnostics)
+
+	teamID := r.client.ResolveTeamID(stateTeamId)
 
-	uga, err := slackutil.GetUserGroupAttributes(r.client, configUsergroupName.ValueString())
+	uga, err := slackutil.GetUserGroupAttributes(r.client.Client, configUsergroupName.ValueString(), teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Retrieving User Group Attributes",
@@ -206,7 +224,7 @@ func (r *resourceUserGroupMember) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	groupAttributes, _, err := slackutil.GetUserGroupByName(r.client, configUsergroupName)
+	groupAttributes, _, err := slackutil.GetUserGroupByName(r.client.Client, configUsergroupName, teamID)
 	if err != nil {
 		errorMsg := "An error occurred while retrieving the user group: " + err.Error()
 		fmt.Printf("API error: %s\n", errorMsg)
@@ -222,9 +240,10 @@ func (r *resourceUserGroupMember) Read(ctx context.Context, req resource.ReadReq
 
 	// Get the current state
 	var state struct {
-		UserGroup   string   `tfsdk:"usergroup"`
-		DefaultUser string   `tfsdk:"default_user"`
-		Users       []string `tfsdk:"users"`
+		UserGroup   string       `tfsdk:"usergroup"`
+		DefaultUser string       `tfsdk:"default_user"`
+		Users       []string     `tfsdk:"users"`
+		TeamID      types.String `tfsdk:"team_id"`
 	}
 
 	// Retrieve the state data
@@ -245,7 +264,7 @@ func (r *resourceUserGroupMember) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Translate email to id
-	usersInfo, err := slackutil.GetUserEmails(r.client, usersId)
+	usersInfo, err := slackutil.GetUserEmails(r.client.Client, usersId, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Id lookup error",
@@ -273,6 +292,7 @@ func (r *resourceUserGroupMember) Read(ctx context.Context, req resource.ReadReq
 	state.UserGroup = uga.Name
 	state.DefaultUser = configDefaultUserEmail.ValueString()
 	state.Users = usersEmail
+	state.TeamID = types.StringValue(teamID)
 
 	// Save the updated state
 	diags = resp.State.Set(ctx, &state)
@@ -315,11 +335,24 @@ User tokens: usergroups:write
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team (workspace) the Slack user group belongs to. Defaults to the provider `team_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
 
 func (r *resourceUserGroupMember) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_group_member", &resp.Diagnostics) {
+		return
+	}
+
 	// Get attributes
 	configUsergroupName, ok := slackutil.GetConfigAttribute[types.String](ctx, req.Config, "usergroup", &resp.Diagnostics)
 	if !ok {
@@ -356,9 +389,10 @@ func (r *resourceUserGroupMember) Update(ctx context.Context, req resource.Updat
 
 	// Get the current state
 	var state struct {
-		UserGroup   string   `tfsdk:"usergroup"`
-		DefaultUser string   `tfsdk:"default_user"`
-		Users       []string `tfsdk:"users"`
+		UserGroup   string       `tfsdk:"usergroup"`
+		DefaultUser string       `tfsdk:"default_user"`
+		Users       []string     `tfsdk:"users"`
+		TeamID      types.String `tfsdk:"team_id"`
 	}
 
 	// Retrieve the state data
@@ -368,8 +402,10 @@ func (r *resourceUserGroupMember) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	teamID := r.client.ResolveTeamID(state.TeamID)
+
 	// Fetch user group attributes
-	uga, err := slackutil.GetUserGroupAttributes(r.client, configUsergroupName.ValueString())
+	uga, err := slackutil.GetUserGroupAttributes(r.client.Client, configUsergroupName.ValueString(), teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Retrieving User Group Attributes",
@@ -379,7 +415,7 @@ func (r *resourceUserGroupMember) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Translate user email to id
-	users, err := slackutil.GetUserIds(r.client, uniqueNewUsersEmail)
+	users, err := slackutil.GetUserIds(r.client.Client, uniqueNewUsersEmail, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Retrieving UserIds",
@@ -399,6 +435,7 @@ func (r *resourceUserGroupMember) Update(ctx context.Context, req resource.Updat
 	state.UserGroup = configUsergroupName.ValueString()
 	state.DefaultUser = configDefaultUserEmail.ValueString()
 	state.Users = configUsersEmail
+	state.TeamID = types.StringValue(teamID)
 
 	// Save the state
 	diags = resp.State.Set(ctx, state)
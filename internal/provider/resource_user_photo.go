@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/slack-go/slack"
+)
+
+var (
+	_ resource.Resource               = (*resourceSlackUserPhoto)(nil)
+	_ resource.ResourceWithModifyPlan = (*resourceSlackUserPhoto)(nil)
+)
+
+type UserPhoto struct {
+	AvatarHash types.String `tfsdk:"avatar_hash"`
+	CropW      types.Int64  `tfsdk:"crop_w"`
+	CropX      types.Int64  `tfsdk:"crop_x"`
+	CropY      types.Int64  `tfsdk:"crop_y"`
+	File       types.String `tfsdk:"file"`
+	FileHash   types.String `tfsdk:"file_hash"`
+	ID         types.String `tfsdk:"id"`
+}
+
+type resourceSlackUserPhoto struct {
+	client *slackClient
+}
+
+func NewResourceSlackUserPhoto() resource.Resource {
+	return &resourceSlackUserPhoto{}
+}
+
+func (r *resourceSlackUserPhoto) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackUserPhoto) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_user_photo"
+}
+
+// ModifyPlan hashes the image so that changes to the file content are planned as an update.
+func (r *resourceSlackUserPhoto) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var file types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("file"), &file)...)
+	if resp.Diagnostics.HasError() || file.IsUnknown() {
+		return
+	}
+
+	hash, err := userPhotoHash(file.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Error Reading User Photo",
+			fmt.Sprintf("Failed to read %s: %s", file.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_hash"), hash)...)
+}
+
+func (r *resourceSlackUserPhoto) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_photo", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserPhoto
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// users.setPhoto always applies to the user the token belongs to
+	authTest, err := r.client.AuthTestContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Slack API AuthTest failed", err.Error())
+		return
+	}
+	data.ID = types.StringValue(authTest.UserID)
+
+	if !r.setPhoto(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Set Slack user photo", map[string]interface{}{
+		"id":        data.ID.ValueString(),
+		"file_hash": data.FileHash.ValueString(),
+	})
+}
+
+func (r *resourceSlackUserPhoto) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_photo", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserPhoto
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteUserPhotoContext(ctx); err != nil {
+		resp.Diagnostics.AddError("Error deleting Slack user photo", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted Slack user photo", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackUserPhoto) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserPhoto
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetUserProfileContext(ctx, &slack.GetUserProfileParameters{UserID: data.ID.ValueString()})
+	if err != nil {
+		if isSlackError(err, "user_not_found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving Slack user profile", err.Error())
+		return
+	}
+
+	// a photo changed or removed outside Terraform no longer matches the uploaded file,
+	// so clear the hash to plan another upload
+	if profile.AvatarHash != data.AvatarHash.ValueString() {
+		tflog.Warn(ctx, "Slack user photo changed outside Terraform", map[string]interface{}{
+			"id":          data.ID.ValueString(),
+			"avatar_hash": profile.AvatarHash,
+		})
+		data.AvatarHash = types.StringValue(profile.AvatarHash)
+		data.FileHash = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSlackUserPhoto) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_user_photo** resource uploads the profile photo of the user the API token belongs to, from a local image file.
+
+Changes to the file content are detected by its SHA-256 hash, and a photo changed in Slack is detected by the ` + "`avatar_hash`" + ` of the user profile. Destroying the resource removes the photo.
+
+**Required scopes**
+
+User tokens: users.profile:write, users.profile:read
+`,
+		Attributes: map[string]schema.Attribute{
+			"avatar_hash": schema.StringAttribute{
+				MarkdownDescription: "The avatar hash Slack assigned to the uploaded photo.",
+				Computed:            true,
+			},
+			"crop_w": schema.Int64Attribute{
+				MarkdownDescription: "The width and height of the square to crop the image to, in pixels.",
+				Optional:            true,
+			},
+			"crop_x": schema.Int64Attribute{
+				MarkdownDescription: "The X coordinate of the top-left corner of the crop square.",
+				Optional:            true,
+			},
+			"crop_y": schema.Int64Attribute{
+				MarkdownDescription: "The Y coordinate of the top-left corner of the crop square.",
+				Optional:            true,
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path of the image file to upload.",
+				Required:            true,
+			},
+			"file_hash": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 hash of the uploaded image file.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the photo belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSlackUserPhoto) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_photo", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state UserPhoto
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	if !r.setPhoto(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Updated Slack user photo", map[string]interface{}{
+		"id":        data.ID.ValueString(),
+		"file_hash": data.FileHash.ValueString(),
+	})
+}
+
+// setPhoto uploads the image with users.setPhoto and records its hash and the resulting avatar hash.
+// The Slack API client is not used as it sends crop_x in place of crop_y.
+func (r *resourceSlackUserPhoto) setPhoto(ctx context.Context, data *UserPhoto, diags *diag.Diagnostics) bool {
+	image, err := os.ReadFile(data.File.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("file"),
+			"Error Reading User Photo",
+			fmt.Sprintf("Failed to read %s: %s", data.File.ValueString(), err.Error()),
+		)
+		return false
+	}
+
+	values := url.Values{}
+	for name, value := range map[string]types.Int64{"crop_w": data.CropW, "crop_x": data.CropX, "crop_y": data.CropY} {
+		if !value.IsNull() {
+			values.Set(name, strconv.FormatInt(value.ValueInt64(), 10))
+		}
+	}
+
+	if err := r.client.CallMultipart(ctx, "users.setPhoto", values, "image", filepath.Base(data.File.ValueString()), image, nil); err != nil {
+		diags.AddError("Error setting Slack user photo", err.Error())
+		return false
+	}
+
+	profile, err := r.client.GetUserProfileContext(ctx, &slack.GetUserProfileParameters{UserID: data.ID.ValueString()})
+	if err != nil {
+		diags.AddError("Error retrieving Slack user profile", err.Error())
+		return false
+	}
+
+	sum := sha256.Sum256(image)
+	data.FileHash = types.StringValue(hex.EncodeToString(sum[:]))
+	data.AvatarHash = types.StringValue(profile.AvatarHash)
+
+	return true
+}
+
+// userPhotoHash returns the hex encoded SHA-256 hash of a file.
+func userPhotoHash(name string) (string, error) {
+	image, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(image)
+	return hex.EncodeToString(sum[:]), nil
+}
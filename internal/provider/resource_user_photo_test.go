@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_user_photo(t *testing.T) {
+	// local stand-in keeping the avatar hash and upload count in memory
+	var mu sync.Mutex
+	avatarHash := "g0default"
+	uploads := 0
+	cropY := ""
+
+	image := filepath.Join(t.TempDir(), "avatar.png")
+	writeImage := func(content string) {
+		if err := os.WriteFile(image, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeImage("first image")
+
+	checkUploads := func(want int) resource.TestCheckFunc {
+		return testCheckStandIn(&mu, func() error {
+			if uploads != want {
+				return fmt.Errorf("expected %d uploads, got %d", want, uploads)
+			}
+			if cropY != "20" {
+				return fmt.Errorf("expected crop_y 20, got %q", cropY)
+			}
+			return nil
+		})
+	}
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"users.setPhoto": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if _, _, err := r.FormFile("image"); err != nil {
+					return `{"ok": false, "error": "no_image_uploaded"}`
+				}
+				uploads++
+				avatarHash = fmt.Sprintf("a0upload%d", uploads)
+				cropY = r.Form.Get("crop_y")
+				return `{"ok": true}`
+			},
+			"users.deletePhoto": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				avatarHash = "g0default"
+				return `{"ok": true}`
+			},
+			"users.profile.get": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				return fmt.Sprintf(`{"ok": true, "profile": {"avatar_hash": "%s"}}`, avatarHash)
+			},
+		},
+		steps: []resource.TestStep{
+			{
+				Config: testSlackUserPhotoConfig(image),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_photo.test", "id", "U0BOT"),
+					resource.TestCheckResourceAttr("slack_user_photo.test", "avatar_hash", "a0upload1"),
+					checkUploads(1),
+				),
+			},
+			{
+				// new file content is uploaded again
+				PreConfig: func() { writeImage("second image") },
+				Config:    testSlackUserPhotoConfig(image),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_photo.test", "avatar_hash", "a0upload2"),
+					checkUploads(2),
+				),
+			},
+			{
+				// a photo changed in Slack is uploaded again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					avatarHash = "a0manual"
+				},
+				Config: testSlackUserPhotoConfig(image),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_photo.test", "avatar_hash", "a0upload3"),
+					checkUploads(3),
+				),
+			},
+		},
+	})
+}
+
+func testSlackUserPhotoConfig(image string) string {
+	return fmt.Sprintf(`
+        resource "slack_user_photo" "test" {
+            file   = "%s"
+            crop_x = 10
+            crop_y = 20
+            crop_w = 256
+        }
+    `, image)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*resourceSlackUserPresence)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceSlackUserPresence)(nil)
+)
+
+// userPresenceValues are the values users.setPresence accepts.
+var userPresenceValues = []string{"auto", "away"}
+
+type resourceSlackUserPresence struct {
+	client *slackClient
+}
+
+type UserPresence struct {
+	ID       types.String `tfsdk:"id"`
+	Presence types.String `tfsdk:"presence"`
+}
+
+func NewResourceSlackUserPresence() resource.Resource {
+	return &resourceSlackUserPresence{}
+}
+
+func (r *resourceSlackUserPresence) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		providerClient, ok := req.ProviderData.(*slackClient)
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
+			return
+		}
+		r.client = providerClient
+	}
+}
+
+func (r *resourceSlackUserPresence) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "slack_user_presence"
+}
+
+func (r *resourceSlackUserPresence) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserPresence
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Presence.IsNull() && !data.Presence.IsUnknown() && !slices.Contains(userPresenceValues, data.Presence.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("presence"),
+			"Invalid Presence",
+			fmt.Sprintf("The presence must be one of %v, got %q.", userPresenceValues, data.Presence.ValueString()),
+		)
+	}
+}
+
+func (r *resourceSlackUserPresence) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_presence", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserPresence
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the method always applies to the user the token belongs to
+	authTest, err := r.client.AuthTestContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Slack API AuthTest failed", err.Error())
+		return
+	}
+	data.ID = types.StringValue(authTest.UserID)
+
+	if err := r.client.SetUserPresenceContext(ctx, data.Presence.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error setting Slack user presence", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Slack user presence set", map[string]interface{}{
+		"id":       data.ID.ValueString(),
+		"presence": data.Presence.ValueString(),
+	})
+}
+
+func (r *resourceSlackUserPresence) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_presence", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserPresence
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetUserPresenceContext(ctx, "auto"); err != nil {
+		resp.Diagnostics.AddError("Error resetting Slack user presence", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Slack user presence reset to auto", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *resourceSlackUserPresence) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserPresence
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	presence, err := r.client.GetUserPresenceContext(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Slack user presence", fmt.Sprintf("Error: %s", err.Error()))
+		return
+	}
+
+	// only a manual away is set with users.setPresence, an automatic away still means auto
+	data.Presence = types.StringValue("auto")
+	if presence.ManualAway {
+		data.Presence = types.StringValue("away")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "Read Slack user presence", map[string]interface{}{
+		"id":          data.ID.ValueString(),
+		"presence":    presence.Presence,
+		"manual_away": presence.ManualAway,
+	})
+}
+
+func (r *resourceSlackUserPresence) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+The **slack_user_presence** resource sets the presence of a Slack user to ` + "`auto`" + ` or ` + "`away`" + `.
+
+The presence applies to the user the API token belongs to. Destroying the resource sets the presence back to ` + "`auto`" + `.
+
+**Required scopes**
+
+User tokens: users:read, users:write
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the API token belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"presence": schema.StringAttribute{
+				MarkdownDescription: "The presence of the user, either `auto` or `away`.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *resourceSlackUserPresence) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_presence", &resp.Diagnostics) {
+		return
+	}
+
+	var data UserPresence
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetUserPresenceContext(ctx, data.Presence.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error updating Slack user presence", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Slack user presence updated", map[string]interface{}{
+		"id":       data.ID.ValueString(),
+		"presence": data.Presence.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func Test_resource_slack_user_presence(t *testing.T) {
+	// local stand-in keeping the presence in memory
+	var mu sync.Mutex
+	presence := "auto"
+
+	runSlackStandInTest(t, slackStandInTest{
+		handlers: map[string]func(r *http.Request) string{
+			"users.setPresence": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				presence = r.Form.Get("presence")
+				return `{"ok": true}`
+			},
+			"users.getPresence": func(r *http.Request) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if presence == "away" {
+					return `{"ok": true, "presence": "away", "manual_away": true}`
+				}
+				return `{"ok": true, "presence": "active", "manual_away": false}`
+			},
+		},
+		checkDestroy: testCheckStandIn(&mu, func() error {
+			if presence != "auto" {
+				return fmt.Errorf("expected presence to be reset to auto, got %s", presence)
+			}
+			return nil
+		}),
+		steps: []resource.TestStep{
+			{
+				Config:      testSlackUserPresenceConfig("busy"),
+				ExpectError: regexp.MustCompile(`Invalid Presence`),
+			},
+			{
+				Config: testSlackUserPresenceConfig("away"),
+				Check:  resource.TestCheckResourceAttr("slack_user_presence.test", "presence", "away"),
+			},
+			{
+				// a presence changed in Slack is set again
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+					presence = "auto"
+				},
+				Config: testSlackUserPresenceConfig("away"),
+				Check: resource.TestCheckFunc(testCheckStandIn(&mu, func() error {
+					if presence != "away" {
+						return fmt.Errorf("expected presence away, got %s", presence)
+					}
+					return nil
+				})),
+			},
+		},
+	})
+}
+
+func testSlackUserPresenceConfig(presence string) string {
+	return fmt.Sprintf(`
+        resource "slack_user_presence" "test" {
+            presence = "%s"
+        }
+    `, presence)
+}
@@ -18,7 +18,7 @@ var (
 )
 
 type resourceSlackUserRealName struct {
-	client *slack.Client
+	client *slackClient
 }
 
 type UserRealName struct {
@@ -37,7 +37,7 @@ func (r *resourceSlackUserRealName) Configure(ctx context.Context, req resource.
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		r.client = providerClient.Client
+		r.client = providerClient
 	}
 }
 
@@ -46,6 +46,10 @@ func (r *resourceSlackUserRealName) Metadata(_ context.Context, req resource.Met
 }
 
 func (r *resourceSlackUserRealName) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_real_name", &resp.Diagnostics) {
+		return
+	}
+
 	var data UserRealName
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -74,6 +78,10 @@ func (r *resourceSlackUserRealName) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *resourceSlackUserRealName) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_real_name", &resp.Diagnostics) {
+		return
+	}
+
 	var data UserRealName
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -148,6 +156,10 @@ User tokens: users:read, users.profile:write
 }
 
 func (r *resourceSlackUserRealName) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_real_name", &resp.Diagnostics) {
+		return
+	}
+
 	var data UserRealName
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -18,7 +18,7 @@ var (
 )
 
 type resourceSlackUserStatus struct {
-	client *slack.Client
+	client *slackClient
 }
 
 type UserStatus struct {
@@ -39,7 +39,7 @@ func (r *resourceSlackUserStatus) Configure(ctx context.Context, req resource.Co
 			resp.Diagnostics.AddError("Invalid Provider Data", "Expected *ConfiguredClient but got something else.")
 			return
 		}
-		r.client = providerClient.Client
+		r.client = providerClient
 	}
 }
 
@@ -48,6 +48,10 @@ func (r *resourceSlackUserStatus) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *resourceSlackUserStatus) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.client.CheckWritable("create", "slack_user_status", &resp.Diagnostics) {
+		return
+	}
+
 	var data UserStatus
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,6 +86,10 @@ func (r *resourceSlackUserStatus) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *resourceSlackUserStatus) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.client.CheckWritable("delete", "slack_user_status", &resp.Diagnostics) {
+		return
+	}
+
 	var data UserStatus
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -180,6 +188,10 @@ User tokens: users:read, users.profile:write
 }
 
 func (r *resourceSlackUserStatus) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.client.CheckWritable("update", "slack_user_status", &resp.Diagnostics) {
+		return
+	}
+
 	var data UserStatus
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
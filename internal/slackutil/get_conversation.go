@@ -18,11 +18,12 @@ import (
 //   - excludeArchived: A boolean indicating whether to exclude archived conversations.
 //   - types: A slice of strings representing the types of conversations to include (e.g., channels, groups).
 //   - queryLimit: An integer specifying the maximum number of conversations to fetch.
+//   - teamID: The workspace to list conversations in. Required for org-level tokens on Enterprise Grid; ignored when empty.
 //
 // Returns:
 //   - A pointer to ConversationDetails if the conversation is found.
 //   - An error if there was an issue retrieving the conversations or if the conversation is not found.
-func GetConversation(api *slack.Client, filter string, filterType string, excludeArchived bool, types []string, queryLimit int) (*ConversationDetails, error) {
+func GetConversation(api *slack.Client, filter string, filterType string, excludeArchived bool, types []string, queryLimit int, teamID string) (*ConversationDetails, error) {
 	var foundConversation *slack.Channel
 
 	// Set a default limit for the number of conversations to fetch
@@ -35,6 +36,7 @@ func GetConversation(api *slack.Client, filter string, filterType string, exclud
 		ExcludeArchived: excludeArchived,
 		Types:           types,
 		Limit:           limit,
+		TeamID:          teamID,
 	}
 
 	var allConversations []slack.Channel
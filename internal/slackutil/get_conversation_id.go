@@ -2,15 +2,16 @@ package slackutil
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/slack-go/slack"
 )
 
-func GetConversationIds(api *slack.Client, channelNames []string, channelTypes []string, limit int) ([]string, error) {
+func GetConversationIds(api *slack.Client, channelNames []string, channelTypes []string, limit int, teamID string) ([]string, error) {
 
 	var channelIds []string
 	for _, channelName := range channelNames {
-		conversation, err := GetConversation(api, channelName, "name", true, channelTypes, limit)
+		conversation, err := GetConversation(api, channelName, "name", true, channelTypes, limit, teamID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve conversation id for channel '%s': %v", channelName, err)
 		}
@@ -19,3 +20,33 @@ func GetConversationIds(api *slack.Client, channelNames []string, channelTypes [
 
 	return channelIds, nil
 }
+
+// GetConversationId resolves a Slack conversation name or ID to a conversation ID.
+//
+// Values that are already conversation IDs (see IsConversationId) are returned unchanged
+// without calling the Slack API. A leading "#" is stripped from names before they are
+// looked up with GetConversation.
+//
+// Parameters:
+//   - api: A pointer to the slack.Client used to interact with the Slack API.
+//   - conversation: The name or ID of the conversation, e.g. "general", "#general" or "C0123456789".
+//   - channelTypes: The types of conversations to search when looking up a name.
+//   - limit: The maximum number of conversations to fetch per page.
+//   - teamID: The workspace to search in. Ignored when empty.
+//
+// Returns:
+//   - The ID of the conversation.
+//   - An error if the conversation name could not be resolved.
+func GetConversationId(api *slack.Client, conversation string, channelTypes []string, limit int, teamID string) (string, error) {
+	if IsConversationId(conversation) {
+		return conversation, nil
+	}
+
+	name := strings.TrimPrefix(conversation, "#")
+	found, err := GetConversation(api, name, "name", false, channelTypes, limit, teamID)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve conversation id for channel '%s': %v", name, err)
+	}
+
+	return found.ID, nil
+}
@@ -6,11 +6,11 @@ import (
 	"github.com/slack-go/slack"
 )
 
-func GetConversationNames(api *slack.Client, channelIds []string, channelTypes []string, limit int) ([]string, error) {
+func GetConversationNames(api *slack.Client, channelIds []string, channelTypes []string, limit int, teamID string) ([]string, error) {
 
 	var channelNames []string
 	for _, channelId := range channelIds {
-		conversation, err := GetConversation(api, channelId, "id", true, channelTypes, limit)
+		conversation, err := GetConversation(api, channelId, "id", true, channelTypes, limit, teamID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve conversation names for channel '%s': %v", channelId, err)
 		}
@@ -10,6 +10,7 @@ import (
 //
 // Parameters:
 //   - api: A pointer to the slack.Client used to interact with the Slack API.
+//   - teamID: The workspace to retrieve. When empty, the workspace of the token is used.
 //
 // Returns:
 //   - A pointer to TeamInfo containing details about the team.
@@ -18,14 +19,20 @@ import (
 // Example usage:
 //
 //	api := slack.New("YOUR_SLACK_BOT_TOKEN")
-//	teamInfo, err := slackutil.GetTeamInfo(api)
+//	teamInfo, err := slackutil.GetTeamInfo(api, "")
 //	if err != nil {
 //	    log.Fatalf("Error: %v", err)
 //	}
 //	fmt.Printf("Team ID: %s, Name: %s, Domain: %s\n", teamInfo.ID, teamInfo.Name, teamInfo.Domain)
 //	fmt.Printf("Team Icon (132px): %s\n", teamInfo.Icon.Image132)
-func GetTeamInfo(api *slack.Client) (*TeamInfo, error) {
-	team, err := api.GetTeamInfo()
+func GetTeamInfo(api *slack.Client, teamID string) (*TeamInfo, error) {
+	var team *slack.TeamInfo
+	var err error
+	if teamID == "" {
+		team, err = api.GetTeamInfo()
+	} else {
+		team, err = api.GetOtherTeamInfo(teamID)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error fetching team info: %w", err)
 	}
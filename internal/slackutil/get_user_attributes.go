@@ -27,6 +27,7 @@ type UserAttributes struct {
 //   - api: A pointer to the slack.Client used to interact with the Slack API.
 //   - value: The email address or user ID of the user to search for.
 //   - filterType: The type of filter to apply, either "email" or "id".
+//   - teamID: The workspace to list users in. Required for org-level tokens on Enterprise Grid; ignored when empty.
 //
 // Returns:
 //   - A pointer to UserAttributes if the user is found.
@@ -36,7 +37,7 @@ type UserAttributes struct {
 //
 //	api := slack.New("YOUR_SLACK_BOT_TOKEN")
 //	email := "user@example.com"
-//	userAttributes, err := GetUserAttributes(api, email, "email")
+//	userAttributes, err := GetUserAttributes(api, "email", email, "")
 //	if err != nil {
 //	    log.Fatalf("Error: %v", err)
 //	}
@@ -44,14 +45,14 @@ type UserAttributes struct {
 //
 //	api := slack.New("YOUR_SLACK_BOT_TOKEN")
 //	id := "U12345"
-//	userAttributes, err := GetUserAttributes(api, id, "id")
+//	userAttributes, err := GetUserAttributes(api, "id", id, "")
 //	if err != nil {
 //	    log.Fatalf("Error: %v", err)
 //	}
 //	fmt.Printf("User ID: %s, Name: %s\n", userAttributes.ID, userAttributes.Name)
-func GetUserAttributes(api *slack.Client, filterType string, value string) (*UserAttributes, error) {
+func GetUserAttributes(api *slack.Client, filterType string, value string, teamID string) (*UserAttributes, error) {
 	// Fetch the list of users
-	users, err := api.GetUsers()
+	users, err := api.GetUsers(slack.GetUsersOptionTeamID(teamID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get users: %w", err)
 	}
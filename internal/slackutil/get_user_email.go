@@ -17,6 +17,7 @@ import (
 // Parameters:
 //   - api: A pointer to the slack.Client used to interact with the Slack API.
 //   - ids: A slice of strings containing the user IDs for which to retrieve emails.
+//   - teamID: The workspace to look the users up in. Ignored when empty.
 //
 // Returns:
 //   - A pointer to a Users struct containing the Emails and IDs fields populated.
@@ -26,15 +27,15 @@ import (
 //
 //	api := slack.New("YOUR_SLACK_BOT_TOKEN")
 //	ids := []string{"U12345", "U67890"}
-//	userEmails, err := GetUserEmails(api, ids)
+//	userEmails, err := GetUserEmails(api, ids, "")
 //	if err != nil {
 //	    log.Fatalf("Error: %v", err)
 //	}
 //	fmt.Printf("User Emails: %v\n", userEmails.Emails)
-func GetUserEmails(api *slack.Client, ids []string) (*Users, error) {
+func GetUserEmails(api *slack.Client, ids []string, teamID string) (*Users, error) {
 	var userEmails []string
 	for _, id := range ids {
-		user, err := GetUserAttributes(api, "id", id)
+		user, err := GetUserAttributes(api, "id", id, teamID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user details for ID %s: %w", id, err)
 		}
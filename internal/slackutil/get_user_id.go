@@ -16,6 +16,7 @@ import (
 // Parameters:
 //   - api: A pointer to the slack.Client used to interact with the Slack API.
 //   - emails: A slice of email addresses for which to retrieve Slack user IDs.
+//   - teamID: The workspace to look the users up in. Ignored when empty.
 //
 // Returns:
 //   - A pointer to a Users struct containing the list of emails and corresponding user IDs.
@@ -25,15 +26,15 @@ import (
 //
 //	api := slack.New("YOUR_SLACK_BOT_TOKEN")
 //	emails := []string{"user1@example.com", "user2@example.com"}
-//	userIDs, err := GetUserIds(api, emails)
+//	userIDs, err := GetUserIds(api, emails, "")
 //	if err != nil {
 //	    log.Fatalf("Error: %v", err)
 //	}
 //	fmt.Printf("Emails: %v, IDs: %v\n", userIDs.Emails, userIDs.IDs)
-func GetUserIds(api *slack.Client, emails []string) (*Users, error) {
+func GetUserIds(api *slack.Client, emails []string, teamID string) (*Users, error) {
 	var userIds []string
 	for _, email := range emails {
-		user, err := GetUserAttributes(api, "email", email)
+		user, err := GetUserAttributes(api, "email", email, teamID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user details for email %s: %w", email, err)
 		}
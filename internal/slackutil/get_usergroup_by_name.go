@@ -45,19 +45,22 @@ type SlackUserGroupAttributes struct {
 //
 // Sample Output:
 //
-//	groupAttributes, exists, err := GetUserGroupByName(client, filterUserGroupName)
+//	groupAttributes, exists, err := GetUserGroupByName(client, filterUserGroupName, "")
 //	// groupAttributes will contain the attributes of the found group or default values if not found.
 //
+// The teamID selects the workspace to search in and is ignored when empty.
+//
 // Returns:
 //
 //	A pointer to SlackUserGroupAttributes containing the details of the user group if found,
 //	or default attributes if not found. The boolean indicates whether the group exists.
 //	If an error occurs while retrieving the user groups, an error is returned.
-func GetUserGroupByName(client *slack.Client, filterUserGroupName types.String) (*SlackUserGroupAttributes, bool, error) {
+func GetUserGroupByName(client *slack.Client, filterUserGroupName types.String, teamID string) (*SlackUserGroupAttributes, bool, error) {
 	// Get user groups from Slack
 	userGroups, err := client.GetUserGroups(
 		slack.GetUserGroupsOptionIncludeUsers(true),
 		slack.GetUserGroupsOptionIncludeDisabled(true),
+		slack.GetUserGroupsOptionWithTeamID(teamID),
 	)
 	if err != nil {
 		errorMsg := "An error occurred while retrieving the user groups: " + err.Error()
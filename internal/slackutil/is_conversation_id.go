@@ -0,0 +1,26 @@
+package slackutil
+
+import (
+	"regexp"
+)
+
+// conversationIdPattern matches Slack conversation IDs for public channels (C), private
+// channels and multi-person DMs (G) and direct messages (D).
+var conversationIdPattern = regexp.MustCompile(`^[CGD][A-Z0-9]{8,}$`)
+
+// IsConversationId reports whether a value is a Slack conversation ID rather than a conversation name.
+//
+// Slack conversation names are always lowercase, so an uppercase value starting with
+// C, G or D is treated as an ID.
+//
+// Sample Input:
+//
+//	value := "C0123456789"
+//
+// Sample Output:
+//
+//	isId := IsConversationId(value)
+//	// isId will be true, while IsConversationId("general") will be false.
+func IsConversationId(value string) bool {
+	return conversationIdPattern.MatchString(value)
+}
@@ -0,0 +1,31 @@
+package slackutil
+
+import "testing"
+
+// TestIsConversationId tests the IsConversationId function with conversation IDs and names.
+func TestIsConversationId(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{name: "PublicChannel", value: "C0123456789", expected: true},
+		{name: "PrivateChannel", value: "G0123456789", expected: true},
+		{name: "DirectMessage", value: "D0123456789", expected: true},
+		{name: "Name", value: "general", expected: false},
+		{name: "NameWithHash", value: "#general", expected: false},
+		{name: "NameStartingWithC", value: "c0123456789", expected: false},
+		{name: "UserId", value: "U0123456789", expected: false},
+		{name: "TooShort", value: "C0123", expected: false},
+		{name: "Empty", value: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsConversationId(tt.value)
+			if result != tt.expected {
+				t.Errorf("Expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package slackutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// IsJSONSubset reports whether the JSON document expected is contained in the JSON document actual.
+//
+// Objects in actual may carry extra keys, which is how Slack decorates Block Kit JSON it
+// returns (e.g. generated "block_id" or "emoji" fields). Arrays must have the same length
+// and their elements are compared in order. All other values must be equal.
+//
+// Sample Input:
+//
+//	expected := `[{"type": "section", "text": {"type": "mrkdwn", "text": "hi"}}]`
+//	actual := `[{"type": "section", "block_id": "Ab1", "text": {"type": "mrkdwn", "text": "hi", "verbatim": false}}]`
+//
+// Sample Output:
+//
+//	contained, err := IsJSONSubset(expected, actual)
+//	// contained will be true.
+//
+// Returns:
+//
+//	true if expected is contained in actual, or an error if either document is not valid JSON.
+func IsJSONSubset(expected string, actual string) (bool, error) {
+	var expectedValue, actualValue any
+
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		return false, fmt.Errorf("invalid expected JSON: %w", err)
+	}
+	if err := json.Unmarshal([]byte(actual), &actualValue); err != nil {
+		return false, fmt.Errorf("invalid actual JSON: %w", err)
+	}
+
+	return isValueSubset(expectedValue, actualValue), nil
+}
+
+func isValueSubset(expected any, actual any) bool {
+	switch expectedValue := expected.(type) {
+	case map[string]any:
+		actualValue, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, value := range expectedValue {
+			actualField, ok := actualValue[key]
+			if !ok || !isValueSubset(value, actualField) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		actualValue, ok := actual.([]any)
+		if !ok || len(expectedValue) != len(actualValue) {
+			return false
+		}
+		for i := range expectedValue {
+			if !isValueSubset(expectedValue[i], actualValue[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
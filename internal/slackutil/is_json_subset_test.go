@@ -0,0 +1,75 @@
+package slackutil
+
+import "testing"
+
+// TestIsJSONSubset tests the IsJSONSubset function with Block Kit style documents.
+func TestIsJSONSubset(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		result   bool
+		err      bool
+	}{
+		{
+			name:     "Identical",
+			expected: `[{"type": "divider"}]`,
+			actual:   `[{"type":"divider"}]`,
+			result:   true,
+		},
+		{
+			name:     "ExtraKeysInActual",
+			expected: `[{"type": "section", "text": {"type": "mrkdwn", "text": "hi"}}]`,
+			actual:   `[{"type": "section", "block_id": "Ab1", "text": {"type": "mrkdwn", "text": "hi", "verbatim": false}}]`,
+			result:   true,
+		},
+		{
+			name:     "ChangedValue",
+			expected: `[{"type": "section", "text": {"type": "mrkdwn", "text": "hi"}}]`,
+			actual:   `[{"type": "section", "text": {"type": "mrkdwn", "text": "hello"}}]`,
+			result:   false,
+		},
+		{
+			name:     "MissingKey",
+			expected: `{"type": "section", "accessory": {"type": "image"}}`,
+			actual:   `{"type": "section"}`,
+			result:   false,
+		},
+		{
+			name:     "ExtraBlock",
+			expected: `[{"type": "divider"}]`,
+			actual:   `[{"type": "divider"}, {"type": "divider"}]`,
+			result:   false,
+		},
+		{
+			name:     "Numbers",
+			expected: `{"size": 1}`,
+			actual:   `{"size": 1.0}`,
+			result:   true,
+		},
+		{
+			name:     "InvalidExpected",
+			expected: `[{`,
+			actual:   `[]`,
+			err:      true,
+		},
+		{
+			name:     "InvalidActual",
+			expected: `[]`,
+			actual:   `not json`,
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := IsJSONSubset(tt.expected, tt.actual)
+			if (err != nil) != tt.err {
+				t.Fatalf("Expected error: %v, got: %v", tt.err, err)
+			}
+			if result != tt.result {
+				t.Errorf("Expected: %v, got: %v", tt.result, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package slackutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// OAuthV2AccessParameters holds the values sent to the Slack oauth.v2.access method.
+//
+// Either Code or RefreshToken must be set. When RefreshToken is set, the exchange is
+// performed with the refresh_token grant type.
+type OAuthV2AccessParameters struct {
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	RefreshToken string
+}
+
+// ExchangeOAuthV2Token exchanges an OAuth code or refresh token for an access token
+// using the Slack oauth.v2.access method.
+//
+// Unlike slack.GetOAuthV2Response, this function honours a custom API URL, which allows
+// the exchange to be performed against a local stand-in endpoint.
+//
+// Parameters:
+//   - ctx: The context used for the HTTP request.
+//   - client: The HTTP client used to send the request. If nil, http.DefaultClient is used.
+//   - apiURL: The base URL of the Slack Web API (e.g. "https://slack.com/api/").
+//   - params: The client credentials and the code or refresh token to exchange.
+//
+// Returns:
+//   - A pointer to slack.OAuthV2Response containing the access token and installation details.
+//   - An error if the request failed or Slack returned an error response.
+//
+// Example usage:
+//
+//	resp, err := ExchangeOAuthV2Token(ctx, nil, slack.APIURL, OAuthV2AccessParameters{
+//	    ClientID:     "123.456",
+//	    ClientSecret: "secret",
+//	    Code:         "code",
+//	})
+//	if err != nil {
+//	    log.Fatalf("Error: %v", err)
+//	}
+//	fmt.Printf("Bot User ID: %s\n", resp.BotUserID)
+func ExchangeOAuthV2Token(ctx context.Context, client *http.Client, apiURL string, params OAuthV2AccessParameters) (*slack.OAuthV2Response, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if params.ClientID == "" || params.ClientSecret == "" {
+		return nil, errors.New("client ID and client secret are required")
+	}
+
+	values := url.Values{
+		"client_id":     {params.ClientID},
+		"client_secret": {params.ClientSecret},
+	}
+
+	switch {
+	case params.Code != "" && params.RefreshToken != "":
+		return nil, errors.New("only one of code or refresh token can be set")
+	case params.Code != "":
+		values.Set("code", params.Code)
+		if params.RedirectURI != "" {
+			values.Set("redirect_uri", params.RedirectURI)
+		}
+	case params.RefreshToken != "":
+		values.Set("grant_type", "refresh_token")
+		values.Set("refresh_token", params.RefreshToken)
+	default:
+		return nil, errors.New("either code or refresh token must be set")
+	}
+
+	endpoint := strings.TrimSuffix(apiURL, "/") + "/oauth.v2.access"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating oauth.v2.access request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpResp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling oauth.v2.access: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, slack.StatusCodeError{Code: httpResp.StatusCode, Status: httpResp.Status}
+	}
+
+	response := &slack.OAuthV2Response{}
+	if err := json.NewDecoder(httpResp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("error decoding oauth.v2.access response: %w", err)
+	}
+
+	return response, response.Err()
+}
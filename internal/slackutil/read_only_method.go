@@ -0,0 +1,66 @@
+package slackutil
+
+import (
+	"strings"
+)
+
+// readOnlyMethodPrefixes lists the prefixes of the final segment of Slack Web API
+// method names that only read data (e.g. "list" in "conversations.list").
+var readOnlyMethodPrefixes = []string{
+	"export",
+	"get",
+	"history",
+	"info",
+	"list",
+	"lookup",
+	"members",
+	"replies",
+	"test",
+	"validate",
+}
+
+// readOnlyMethodNamespaces lists Slack Web API namespaces where every method only reads data.
+var readOnlyMethodNamespaces = []string{
+	"search.",
+}
+
+// IsReadOnlyMethod reports whether a Slack Web API method only reads data.
+//
+// The method name may be given on its own or as a URL path ending with the method
+// name (e.g. "/api/conversations.list"). Methods are classified by the final segment
+// of their name, so "usergroups.users.list" and "users.getPresence" are read-only while
+// "usergroups.users.update" and "chat.postMessage" are not.
+//
+// Sample Input:
+//
+//	method := "conversations.list"
+//
+// Sample Output:
+//
+//	readOnly := IsReadOnlyMethod(method)
+//	// readOnly will be true.
+//
+// Returns:
+//
+//	true if the method only reads data; otherwise false, including for an empty method name.
+func IsReadOnlyMethod(method string) bool {
+	method = method[strings.LastIndex(method, "/")+1:]
+	if method == "" {
+		return false
+	}
+
+	for _, namespace := range readOnlyMethodNamespaces {
+		if strings.HasPrefix(method, namespace) {
+			return true
+		}
+	}
+
+	segment := method[strings.LastIndex(method, ".")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(segment, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,38 @@
+package slackutil
+
+import "testing"
+
+// TestIsReadOnlyMethod tests the IsReadOnlyMethod function with read and write Slack methods.
+func TestIsReadOnlyMethod(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		expected bool
+	}{
+		{name: "AuthTest", method: "auth.test", expected: true},
+		{name: "ConversationsList", method: "conversations.list", expected: true},
+		{name: "ConversationsInfo", method: "conversations.info", expected: true},
+		{name: "ConversationsHistory", method: "conversations.history", expected: true},
+		{name: "UsersGetPresence", method: "users.getPresence", expected: true},
+		{name: "UsersLookupByEmail", method: "users.lookupByEmail", expected: true},
+		{name: "UsergroupsUsersList", method: "usergroups.users.list", expected: true},
+		{name: "SearchMessages", method: "search.messages", expected: true},
+		{name: "URLPath", method: "/api/users.list", expected: true},
+		{name: "ChatPostMessage", method: "chat.postMessage", expected: false},
+		{name: "UsergroupsUpdate", method: "usergroups.update", expected: false},
+		{name: "UsergroupsUsersUpdate", method: "usergroups.users.update", expected: false},
+		{name: "UsersProfileSet", method: "users.profile.set", expected: false},
+		{name: "TokensRotate", method: "tooling.tokens.rotate", expected: false},
+		{name: "URLPathWrite", method: "/api/usergroups.create", expected: false},
+		{name: "Empty", method: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsReadOnlyMethod(tt.method)
+			if result != tt.expected {
+				t.Errorf("Expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+}
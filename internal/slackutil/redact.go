@@ -0,0 +1,101 @@
+package slackutil
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces sensitive values in log output.
+const RedactedValue = "***"
+
+// TokenPattern matches Slack tokens (e.g. xoxb-, xoxp-, xoxe.xoxp-, xapp-) wherever they appear in a string.
+var TokenPattern = regexp.MustCompile(`\b(?:xox[abeposr](?:\.xox[abeposr])?|xapp)-[A-Za-z0-9-]+`)
+
+// sensitiveKeys lists parameter and header names whose values are always redacted.
+var sensitiveKeys = map[string]struct{}{
+	"authorization":       {},
+	"client_secret":       {},
+	"code":                {},
+	"cookie":              {},
+	"proxy-authorization": {},
+	"refresh_token":       {},
+	"set-cookie":          {},
+	"token":               {},
+	"x-slack-signature":   {},
+}
+
+// RedactString masks every Slack token found in a string.
+//
+// Sample Input:
+//
+//	s := "Bearer xoxb-1234-abcd"
+//
+// Sample Output:
+//
+//	redacted := RedactString(s)
+//	// redacted will be "Bearer ***".
+func RedactString(s string) string {
+	return TokenPattern.ReplaceAllString(s, RedactedValue)
+}
+
+// RedactValues returns a copy of URL values suitable for logging.
+//
+// Values of sensitive keys such as token, client_secret and refresh_token are fully masked,
+// and any Slack token found in the remaining values is masked as well. Multiple values for the
+// same key are joined with a comma.
+//
+// Sample Input:
+//
+//	values := url.Values{"token": {"xoxb-1"}, "channel": {"C123"}}
+//
+// Sample Output:
+//
+//	redacted := RedactValues(values)
+//	// redacted will be map[string]string{"token": "***", "channel": "C123"}.
+func RedactValues(values url.Values) map[string]string {
+	redacted := make(map[string]string, len(values))
+	for key, value := range values {
+		redacted[key] = redactValue(key, strings.Join(value, ","))
+	}
+	return redacted
+}
+
+// RedactHeaders returns a copy of HTTP headers suitable for logging.
+//
+// Values of sensitive headers such as Authorization and Cookie are fully masked, and any
+// Slack token found in the remaining values is masked as well. Header names are matched
+// case-insensitively and keep the case they were given in.
+//
+// Sample Input:
+//
+//	headers := map[string]string{"Authorization": "Bearer xoxb-1", "Accept": "application/json"}
+//
+// Sample Output:
+//
+//	redacted := RedactHeaders(headers)
+//	// redacted will be map[string]string{"Authorization": "***", "Accept": "application/json"}.
+func RedactHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for key, value := range headers {
+		redacted[key] = redactValue(key, value)
+	}
+	return redacted
+}
+
+// RedactHTTPHeaders is like RedactHeaders for an http.Header.
+func RedactHTTPHeaders(headers http.Header) map[string]string {
+	flattened := make(map[string]string, len(headers))
+	for key, value := range headers {
+		flattened[key] = strings.Join(value, ",")
+	}
+	return RedactHeaders(flattened)
+}
+
+func redactValue(key string, value string) string {
+	if _, ok := sensitiveKeys[strings.ToLower(key)]; ok {
+		return RedactedValue
+	}
+	return RedactString(value)
+}
@@ -2,6 +2,7 @@ package slackutil
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/slack-go/slack"
 )
@@ -28,16 +29,17 @@ type UserGroupAttributes struct {
 	UserEmails  []string
 }
 
-// GetUserGroupAttributes retrieves the attributes of a Slack user group by its name.
+// GetUserGroupAttributes retrieves the attributes of a Slack user group by its name, handle or ID.
 // This function uses the provided Slack API client to fetch all user groups and searches
-// for the group that matches the specified name. If the group is found, it returns a
+// for the group whose name matches the specified name, or else whose handle or ID does. If the group is found, it returns a
 // pointer to a UserGroupAttributes struct containing the group's attributes, including
 // the ID, name, description, handle, and auto type. If the group is not found or an error
 // occurs while fetching the user groups, it returns an error.
 //
 // Parameters:
 //   - api: A pointer to the slack.Client used to interact with the Slack API.
-//   - groupName: The name of the user group to search for.
+//   - groupName: The name, handle or ID of the user group to search for.
+//   - teamID: The workspace to search in. Required for org-level tokens on Enterprise Grid; ignored when empty.
 //
 // Returns:
 //   - A pointer to UserGroupAttributes if the user group is found.
@@ -47,58 +49,94 @@ type UserGroupAttributes struct {
 //
 //	api := slack.New("YOUR_SLACK_BOT_TOKEN")
 //	groupName := "desired_user_group_name"
-//	groupAttributes, err := GetUserGroupAttributes(api, groupName)
+//	groupAttributes, err := GetUserGroupAttributes(api, groupName, "")
 //	if err != nil {
 //	    log.Fatalf("Error: %v", err)
 //	}
 //	fmt.Printf("User Group ID: %s\n", groupAttributes.ID)
-func GetUserGroupAttributes(api *slack.Client, groupName string) (*UserGroupAttributes, error) {
-	// Fetch the list of user groups
+func GetUserGroupAttributes(api *slack.Client, groupName string, teamID string) (*UserGroupAttributes, error) {
+	group, err := findUserGroup(api, groupName, teamID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a UserGroupAttributes instance
+	uga := &UserGroupAttributes{
+		AutoType:    group.AutoType,
+		Channels:    group.Prefs.Channels,
+		CreatedBy:   group.CreatedBy,
+		DateCreate:  int64(group.DateCreate),
+		DateDelete:  int64(group.DateDelete),
+		DateUpdate:  int64(group.DateUpdate),
+		DeletedBy:   group.DeletedBy,
+		Description: group.Description,
+		Groups:      group.Prefs.Groups,
+		Handle:      group.Handle,
+		ID:          group.ID,
+		IsExternal:  group.IsExternal,
+		IsUsergroup: group.IsUserGroup,
+		Name:        group.Name,
+		TeamID:      group.TeamID,
+		UpdatedBy:   group.UpdatedBy,
+		UserCount:   group.UserCount,
+		UserIds:     group.Users,
+	}
+
+	// Call GetUserEmails to populate UserEmails
+	if _, err := uga.GetUserEmails(api, teamID); err != nil {
+		return nil, fmt.Errorf("failed to get emails for user group '%s': %w", groupName, err)
+	}
+
+	// Return the populated group attributes
+	return uga, nil
+}
+
+// GetUserGroupID returns the ID of a Slack user group given by its name, handle or ID, matched
+// as in GetUserGroupAttributes. Unlike GetUserGroupAttributes, it neither lists the members nor
+// looks up their email addresses, so it takes a single API call whatever the size of the group.
+//
+// Parameters:
+//   - api: A pointer to the slack.Client used to interact with the Slack API.
+//   - groupName: The name, handle or ID of the user group to search for.
+//   - teamID: The workspace to search in. Ignored when empty.
+//
+// Returns:
+//   - The ID of the user group.
+//   - An error if there was an issue retrieving the user groups or if the group is not found.
+func GetUserGroupID(api *slack.Client, groupName string, teamID string) (string, error) {
+	group, err := findUserGroup(api, groupName, teamID, false)
+	if err != nil {
+		return "", err
+	}
+	return group.ID, nil
+}
+
+// findUserGroup returns the user group whose name matches groupName, falling back to its handle
+// or ID only when no name matches, so that a name is never shadowed by another group's handle.
+// The members are only listed when includeUsers is true.
+func findUserGroup(api *slack.Client, groupName string, teamID string, includeUsers bool) (*slack.UserGroup, error) {
 	userGroups, err := api.GetUserGroups(
-		slack.GetUserGroupsOptionIncludeUsers(true),
-		slack.GetUserGroupsOptionIncludeCount(true),
+		slack.GetUserGroupsOptionIncludeUsers(includeUsers),
+		slack.GetUserGroupsOptionIncludeCount(includeUsers),
 		slack.GetUserGroupsOptionIncludeDisabled(true),
+		slack.GetUserGroupsOptionWithTeamID(teamID),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user groups: %w", err)
 	}
 
-	// Iterate through the user groups to find the one with the given name
-	for _, group := range userGroups {
-		if group.Name == groupName {
-			// Create a UserGroupAttributes instance
-			uga := &UserGroupAttributes{
-				AutoType:    group.AutoType,
-				Channels:    group.Prefs.Channels,
-				CreatedBy:   group.CreatedBy,
-				DateCreate:  int64(group.DateCreate),
-				DateDelete:  int64(group.DateDelete),
-				DateUpdate:  int64(group.DateUpdate),
-				DeletedBy:   group.DeletedBy,
-				Description: group.Description,
-				Groups:      group.Prefs.Groups,
-				Handle:      group.Handle,
-				ID:          group.ID,
-				IsExternal:  group.IsExternal,
-				IsUsergroup: group.IsUserGroup,
-				Name:        group.Name,
-				TeamID:      group.TeamID,
-				UpdatedBy:   group.UpdatedBy,
-				UserCount:   group.UserCount,
-				UserIds:     group.Users,
-			}
-
-			// Call GetUserEmails to populate UserEmails
-			if _, err := uga.GetUserEmails(api); err != nil {
-				return nil, fmt.Errorf("failed to get emails for user group '%s': %w", groupName, err)
-			}
-
-			// Return the populated group attributes
-			return uga, nil
-		}
+	index := slices.IndexFunc(userGroups, func(group slack.UserGroup) bool {
+		return group.Name == groupName
+	})
+	if index < 0 {
+		index = slices.IndexFunc(userGroups, func(group slack.UserGroup) bool {
+			return group.Handle == groupName || group.ID == groupName
+		})
 	}
-
-	return nil, fmt.Errorf("user group '%s' not found", groupName)
+	if index < 0 {
+		return nil, fmt.Errorf("user group '%s' not found", groupName)
+	}
+	return &userGroups[index], nil
 }
 
 // GetUserEmails retrieves the email addresses of users associated with the user group.
@@ -106,14 +144,15 @@ func GetUserGroupAttributes(api *slack.Client, groupName string) (*UserGroupAttr
 //
 // Parameters:
 // - api: A pointer to a slack.Client for making API calls.
+// - teamID: The workspace to look the users up in. Ignored when empty.
 //
 // Returns:
 // - A slice of strings containing email addresses of users in the group.
 // - An error if any occurred during the process of retrieving user details.
-func (uga *UserGroupAttributes) GetUserEmails(api *slack.Client) ([]string, error) {
+func (uga *UserGroupAttributes) GetUserEmails(api *slack.Client, teamID string) ([]string, error) {
 	var emails []string
 	for _, userId := range uga.UserIds { // Use UserIds from the populated UserGroupAttributes
-		user, err := GetUserAttributes(api, "id", userId)
+		user, err := GetUserAttributes(api, "id", userId, teamID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user details for ID %s: %w", userId, err)
 		}